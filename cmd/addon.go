@@ -0,0 +1,174 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+const (
+	flagAddonRepository = "repository"
+
+	defaultAddonRepository = "official-addons"
+	addonVersionLatest     = "latest"
+)
+
+func newAddonCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "addon",
+		Short: "Manage cluster add-ons",
+	}
+}
+
+// getCatalogAddon gets add-on from the catalog by name passed as first argument or shows interactive selection list.
+// If version is empty the latest add-on version is returned.
+func getCatalogAddon(cmd *cobra.Command, api client.Interface, repository, version string) (*sdk.Addon, error) {
+	ctx := cmd.Context()
+	if version == "" {
+		version = addonVersionLatest
+	}
+
+	if len(cmd.Flags().Args()) == 0 {
+		return selectCatalogAddon(ctx, api, repository, version)
+	}
+
+	name := cmd.Flags().Args()[0]
+	items, err := api.ListAddons(ctx, &sdk.ListAddonsParams{
+		Names:        &[]string{name},
+		Versions:     &[]string{version},
+		Repositories: &[]string{repository},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if strings.EqualFold(item.Name, name) && (version == addonVersionLatest || item.Version == version) {
+			return &item, nil
+		}
+	}
+	return nil, fmt.Errorf("add-on not found, name=%s version=%s repository=%s", name, version, repository)
+}
+
+// selectCatalogAddon shows interactive add-ons catalog selection list and returns selected add-on.
+func selectCatalogAddon(ctx context.Context, api client.Interface, repository, version string) (*sdk.Addon, error) {
+	items, err := api.ListAddons(ctx, &sdk.ListAddonsParams{
+		Versions:     &[]string{version},
+		Repositories: &[]string{repository},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.New("no add-ons found")
+	}
+	displayName := func(item sdk.Addon) string {
+		return fmt.Sprintf("%-20s %s", item.Name, item.Version)
+	}
+	selectList := make([]string, len(items))
+	for i, item := range items {
+		selectList[i] = displayName(item)
+	}
+
+	var selected string
+	prompt := &survey.Select{
+		Message: "Select add-on:",
+		Options: selectList,
+		Default: selectList[0],
+	}
+
+	if err := survey.AskOne(prompt, &selected, survey.WithValidator(survey.Required)); err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if displayName(item) == selected {
+			return &item, nil
+		}
+	}
+
+	return nil, errors.New("add-on not found")
+}
+
+// getClusterAddon gets installed cluster add-on by name passed as first argument or shows interactive selection list.
+func getClusterAddon(cmd *cobra.Command, api client.Interface, clusterID, repository string) (*sdk.ClusterAddon, error) {
+	ctx := cmd.Context()
+	if len(cmd.Flags().Args()) == 0 {
+		return selectClusterAddon(ctx, api, clusterID)
+	}
+
+	name := cmd.Flags().Args()[0]
+	return api.GetClusterAddon(ctx, sdk.ClusterId(clusterID), repository, name)
+}
+
+// selectClusterAddon shows interactive installed add-ons selection list and returns selected add-on.
+func selectClusterAddon(ctx context.Context, api client.Interface, clusterID string) (*sdk.ClusterAddon, error) {
+	items, err := api.GetClusterAddons(ctx, sdk.ClusterId(clusterID))
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.New("no installed add-ons found")
+	}
+	displayName := func(item sdk.ClusterAddon) string {
+		return fmt.Sprintf("%-20s %s %s", item.Addon.Name, item.Addon.Version, item.Status)
+	}
+	selectList := make([]string, len(items))
+	for i, item := range items {
+		selectList[i] = displayName(item)
+	}
+
+	var selected string
+	prompt := &survey.Select{
+		Message: "Select add-on:",
+		Options: selectList,
+		Default: selectList[0],
+	}
+
+	if err := survey.AskOne(prompt, &selected, survey.WithValidator(survey.Required)); err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if displayName(item) == selected {
+			return &item, nil
+		}
+	}
+
+	return nil, errors.New("add-on not found")
+}
+
+// parseAddonValuesOverrides parses helm like values overrides, eg. --set=replicas=2 --set=image.tag=v1.0.0
+func parseAddonValuesOverrides(values []string) (map[string]string, error) {
+	res := make(map[string]string, len(values))
+	for _, v := range values {
+		p := strings.SplitN(v, "=", 2)
+		if len(p) != 2 || strings.TrimSpace(p[0]) == "" {
+			return nil, fmt.Errorf("unknown value override format %q, it should contain key and value, eg. --set=replicas=2", v)
+		}
+		res[strings.TrimSpace(p[0])] = p[1]
+	}
+	return res, nil
+}
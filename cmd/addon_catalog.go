@@ -0,0 +1,80 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+)
+
+func newAddonCatalogCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	var allVersions bool
+	cmd := &cobra.Command{
+		Use:   "catalog",
+		Short: "List available add-ons",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleAddonCatalog(cmd, api, allVersions); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().BoolVar(&allVersions, "all-versions", false, "show all add-on versions instead of the latest only")
+	command.AddJSONOutput(cmd)
+	return cmd
+}
+
+func handleAddonCatalog(cmd *cobra.Command, api client.Interface, allVersions bool) error {
+	req := &sdk.ListAddonsParams{}
+	if !allVersions {
+		req.Versions = &[]string{addonVersionLatest}
+	}
+	res, err := api.ListAddons(cmd.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	if command.OutputJSON() {
+		command.PrintOutput(res)
+		return nil
+	}
+
+	printAddonCatalogTable(cmd.OutOrStdout(), res)
+	return nil
+}
+
+func printAddonCatalogTable(out io.Writer, items []sdk.Addon) {
+	t := table.NewWriter()
+	t.SetStyle(command.DefaultTableStyle)
+	t.SetOutputMirror(out)
+	t.AppendHeader(table.Row{"Name", "Version", "Repository", "Description"})
+	for _, item := range items {
+		t.AppendRow(table.Row{
+			item.Name,
+			item.Version,
+			item.Repository,
+			nodeValueString(item.ShortDescription),
+		})
+	}
+	t.Render()
+}
@@ -0,0 +1,91 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+type addonInstallOptions struct {
+	Repository string
+	Version    string
+	Values     []string
+}
+
+func newAddonInstallCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := addonInstallOptions{}
+	cmd := &cobra.Command{
+		Use:   "install <addon_name>",
+		Short: "Install add-on to cluster",
+		Long: `
+Examples:
+  # Install latest grafana add-on version.
+  cast addon install grafana -c my-cluster
+
+  # Install specific add-on version with custom values.
+  cast addon install grafana -c my-cluster --version=6.1.0 --set=replicas=2 --set=persistence.enabled=true
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleAddonInstall(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringP(flagCluster, "c", "", "cluster name or ID")
+	cmd.PersistentFlags().StringVar(&opts.Repository, flagAddonRepository, defaultAddonRepository, "add-on repository")
+	cmd.PersistentFlags().StringVar(&opts.Version, "version", "", "add-on version, latest version is used if not set")
+	cmd.PersistentFlags().StringArrayVar(&opts.Values, "set", []string{}, "add-on values overrides, eg. --set=replicas=2")
+	return cmd
+}
+
+func handleAddonInstall(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts addonInstallOptions) error {
+	overrides, err := parseAddonValuesOverrides(opts.Values)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := getClusterFromFlag(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	addon, err := getCatalogAddon(cmd, api, opts.Repository, opts.Version)
+	if err != nil {
+		return err
+	}
+
+	req := sdk.InstallClusterAddonJSONRequestBody{
+		Name:       addon.Name,
+		Repository: addon.Repository,
+		Version:    addon.Version,
+	}
+	if len(overrides) > 0 {
+		req.ValuesOverrides = &sdk.InstallAddonRequest_ValuesOverrides{AdditionalProperties: overrides}
+	}
+
+	res, err := api.InstallClusterAddon(cmd.Context(), sdk.ClusterId(cluster.Id), req)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Add-on %s %s installation is now in progress, status: %s", res.Addon.Name, res.Addon.Version, res.Status)
+	return nil
+}
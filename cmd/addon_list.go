@@ -0,0 +1,80 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+)
+
+func newAddonListCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List cluster installed add-ons",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleAddonList(cmd, api); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringP(flagCluster, "c", "", "cluster name or ID")
+	command.AddJSONOutput(cmd)
+	return cmd
+}
+
+func handleAddonList(cmd *cobra.Command, api client.Interface) error {
+	cluster, err := getClusterFromFlag(cmd, api)
+	if err != nil {
+		return err
+	}
+	res, err := api.GetClusterAddons(cmd.Context(), sdk.ClusterId(cluster.Id))
+	if err != nil {
+		return err
+	}
+
+	if command.OutputJSON() {
+		command.PrintOutput(res)
+		return nil
+	}
+
+	printClusterAddonsTable(cmd.OutOrStdout(), res)
+	return nil
+}
+
+func printClusterAddonsTable(out io.Writer, items []sdk.ClusterAddon) {
+	t := table.NewWriter()
+	t.SetStyle(command.DefaultTableStyle)
+	t.SetOutputMirror(out)
+	t.AppendHeader(table.Row{"Name", "Version", "Repository", "Status", "URL"})
+	for _, item := range items {
+		t.AppendRow(table.Row{
+			item.Addon.Name,
+			item.Addon.Version,
+			item.Addon.Repository,
+			item.Status,
+			item.ClusterGatewayUrl,
+		})
+	}
+	t.Render()
+}
@@ -0,0 +1,76 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+func newAddonUninstallCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	var repository string
+	var confirm bool
+	cmd := &cobra.Command{
+		Use:   "uninstall <addon_name>",
+		Short: "Uninstall add-on from cluster",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleAddonUninstall(cmd, log, api, repository, confirm); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringP(flagCluster, "c", "", "cluster name or ID")
+	cmd.PersistentFlags().StringVar(&repository, flagAddonRepository, defaultAddonRepository, "add-on repository")
+	cmd.PersistentFlags().BoolVarP(&confirm, "yes", "y", false, "confirm add-on uninstall")
+	return cmd
+}
+
+func handleAddonUninstall(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, repository string, confirm bool) error {
+	cluster, err := getClusterFromFlag(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	addon, err := getClusterAddon(cmd, api, cluster.Id, repository)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		if err := survey.AskOne(&survey.Confirm{
+			Message: "Are you sure?",
+		}, &confirm); err != nil {
+			return err
+		}
+	}
+
+	if !confirm {
+		log.Info("Add-on uninstall canceled")
+		return nil
+	}
+
+	if err := api.DeleteClusterAddon(cmd.Context(), sdk.ClusterId(cluster.Id), addon.Addon.Repository, addon.Addon.Name); err != nil {
+		return err
+	}
+
+	log.Infof("Add-on %s uninstall is now in progress", addon.Addon.Name)
+	return nil
+}
@@ -0,0 +1,110 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+type addonUpgradeOptions struct {
+	Repository string
+	Version    string
+	Values     []string
+}
+
+func newAddonUpgradeCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := addonUpgradeOptions{}
+	cmd := &cobra.Command{
+		Use:   "upgrade <addon_name>",
+		Short: "Upgrade cluster add-on",
+		Long: `
+Examples:
+  # Upgrade grafana add-on to the latest version.
+  cast addon upgrade grafana -c my-cluster
+
+  # Upgrade add-on to specific version with custom values.
+  cast addon upgrade grafana -c my-cluster --version=6.2.0 --set=replicas=3
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleAddonUpgrade(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringP(flagCluster, "c", "", "cluster name or ID")
+	cmd.PersistentFlags().StringVar(&opts.Repository, flagAddonRepository, defaultAddonRepository, "add-on repository")
+	cmd.PersistentFlags().StringVar(&opts.Version, "version", "", "add-on version, latest version is used if not set")
+	cmd.PersistentFlags().StringArrayVar(&opts.Values, "set", []string{}, "add-on values overrides, eg. --set=replicas=2")
+	return cmd
+}
+
+func handleAddonUpgrade(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts addonUpgradeOptions) error {
+	overrides, err := parseAddonValuesOverrides(opts.Values)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := getClusterFromFlag(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	installed, err := getClusterAddon(cmd, api, cluster.Id, opts.Repository)
+	if err != nil {
+		return err
+	}
+
+	version := opts.Version
+	if version == "" {
+		latest, err := api.ListAddons(cmd.Context(), &sdk.ListAddonsParams{
+			Names:        &[]string{installed.Addon.Name},
+			Versions:     &[]string{addonVersionLatest},
+			Repositories: &[]string{installed.Addon.Repository},
+		})
+		if err != nil {
+			return err
+		}
+		version = installed.Addon.Version
+		if len(latest) > 0 {
+			version = latest[0].Version
+		}
+	}
+
+	if version == installed.Addon.Version && len(overrides) == 0 {
+		log.Infof("Add-on %s is already at version %s", installed.Addon.Name, version)
+		return nil
+	}
+
+	req := sdk.UpdateClusterAddonJSONRequestBody{
+		Version: &version,
+	}
+	if len(overrides) > 0 {
+		req.ValuesOverrides = &sdk.UpdateAddonRequest_ValuesOverrides{AdditionalProperties: overrides}
+	}
+
+	res, err := api.UpdateClusterAddon(cmd.Context(), sdk.ClusterId(cluster.Id), installed.Addon.Repository, installed.Addon.Name, req)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Add-on %s upgrade to %s is now in progress, status: %s", res.Addon.Name, res.Addon.Version, res.Status)
+	return nil
+}
@@ -0,0 +1,197 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+)
+
+const (
+	flagAuditCursor = "cursor"
+
+	defaultAuditPageLimit = 100
+)
+
+type auditListOptions struct {
+	From    string
+	To      string
+	Cluster string
+	Limit   int
+	Cursor  string
+}
+
+func newAuditListCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := auditListOptions{}
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List audit events",
+		Long: `
+By default all pages of audit events are fetched. Pass --cursor to fetch one page at a time,
+next page cursor is printed after the results.
+
+Examples:
+  # List audit events of the last 7 days.
+  cast audit list --from=7d
+
+  # List cluster audit events page by page.
+  cast audit list --cluster=my-cluster --limit=20 --cursor=
+  cast audit list --cluster=my-cluster --limit=20 --cursor=<next_cursor>
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleAuditList(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVar(&opts.From, "from", "", "show events after given time, eg. --from=2021-01-31 or --from=24h")
+	cmd.PersistentFlags().StringVar(&opts.To, "to", "", "show events before given time, eg. --to=2021-02-28T00:00:00Z")
+	cmd.PersistentFlags().StringVarP(&opts.Cluster, flagCluster, "c", "", "show events of given cluster name or ID only")
+	cmd.PersistentFlags().IntVar(&opts.Limit, "limit", defaultAuditPageLimit, "events page size, between 1 and 500")
+	cmd.PersistentFlags().StringVar(&opts.Cursor, flagAuditCursor, "", "fetch single page starting from given cursor, pass empty cursor to fetch the first page")
+	command.AddJSONOutput(cmd)
+	return cmd
+}
+
+func handleAuditList(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts auditListOptions) error {
+	req, err := toListAuditEventsParams(cmd, api, opts)
+	if err != nil {
+		return err
+	}
+
+	// Fetch single page if cursor is passed.
+	if cmd.Flags().Changed(flagAuditCursor) {
+		if opts.Cursor != "" {
+			cursor := sdk.Cursor(opts.Cursor)
+			req.Cursor = &cursor
+		}
+		res, err := api.ListAuditEvents(cmd.Context(), req)
+		if err != nil {
+			return err
+		}
+
+		if command.OutputJSON() {
+			command.PrintOutput(res)
+			return nil
+		}
+
+		printAuditEventsTable(cmd.OutOrStdout(), res.Items)
+		if res.NextCursor != "" {
+			log.Infof("More events available, run with --cursor=%s to get the next page", res.NextCursor)
+		}
+		return nil
+	}
+
+	items, err := listAllAuditEvents(cmd.Context(), api, req)
+	if err != nil {
+		return err
+	}
+
+	if command.OutputJSON() {
+		command.PrintOutput(items)
+		return nil
+	}
+
+	printAuditEventsTable(cmd.OutOrStdout(), items)
+	return nil
+}
+
+// listAllAuditEvents follows next page cursors until the last page.
+func listAllAuditEvents(ctx context.Context, api client.Interface, req *sdk.ListAuditEventsParams) ([]sdk.AuditEvent, error) {
+	var items []sdk.AuditEvent
+	seen := map[string]struct{}{}
+	for {
+		res, err := api.ListAuditEvents(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, res.Items...)
+		if res.NextCursor == "" {
+			return items, nil
+		}
+		// Guard against API returning the same cursor again which would loop forever.
+		if _, ok := seen[res.NextCursor]; ok {
+			return nil, fmt.Errorf("audit events pagination cursor %s repeated", res.NextCursor)
+		}
+		seen[res.NextCursor] = struct{}{}
+		cursor := sdk.Cursor(res.NextCursor)
+		req.Cursor = &cursor
+	}
+}
+
+func toListAuditEventsParams(cmd *cobra.Command, api client.Interface, opts auditListOptions) (*sdk.ListAuditEventsParams, error) {
+	if opts.Limit < 1 || opts.Limit > 500 {
+		usagef(cmd, "limit should be between 1 and 500")
+	}
+	limit := sdk.Limit(opts.Limit)
+	req := &sdk.ListAuditEventsParams{
+		Limit: &limit,
+	}
+
+	if opts.From != "" {
+		from, err := parseTimeFlag(opts.From)
+		if err != nil {
+			return nil, err
+		}
+		v := sdk.FilterFromDate(from.Format(time.RFC3339))
+		req.FromDate = &v
+	}
+	if opts.To != "" {
+		to, err := parseTimeFlag(opts.To)
+		if err != nil {
+			return nil, err
+		}
+		v := sdk.FilterToDate(to.Format(time.RFC3339))
+		req.ToDate = &v
+	}
+	if opts.Cluster != "" {
+		cluster, err := getCluster(cmd.Context(), api, opts.Cluster)
+		if err != nil {
+			return nil, err
+		}
+		v := sdk.FilterClusterId(cluster.Id)
+		req.ClusterId = &v
+	}
+
+	return req, nil
+}
+
+func printAuditEventsTable(out io.Writer, items []sdk.AuditEvent) {
+	t := table.NewWriter()
+	t.SetStyle(command.DefaultTableStyle)
+	t.SetOutputMirror(out)
+	t.AppendHeader(table.Row{"Time", "Event", "Initiated_By", "Email"})
+	for _, item := range items {
+		t.AppendRow(table.Row{
+			item.Time.Format(time.RFC3339),
+			item.EventType,
+			item.InitiatedBy.Name,
+			nodeValueString(item.InitiatedBy.Email),
+		})
+	}
+	t.Render()
+}
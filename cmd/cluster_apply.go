@@ -0,0 +1,208 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+type clusterApplyOptions struct {
+	File    string
+	Wait    bool
+	Prune   bool
+	Confirm bool
+}
+
+func newClusterApplyCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := clusterApplyOptions{}
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Create or update cluster from spec file",
+		Long: `
+Cluster spec file can be written in YAML or JSON. If cluster with given name doesn't exist
+it is created, otherwise credentials, network and nodes are updated to match the spec.
+Existing nodes missing from the spec are deleted only with --prune, cluster can't be left
+without master nodes. Addons are applied only when cluster is created. Waiting with --wait
+is supported only when cluster is created.
+
+Example spec file:
+  apiVersion: v1
+  kind: Cluster
+  name: my-demo-cluster
+  region: eu-central
+  credentials:
+    - aws
+    - gcp
+  nodes:
+    - aws-master-medium
+    - aws-worker-small
+    - gcp-worker-medium
+    - aws-worker-large:spot
+  network:
+    vpn: wireguard_cross_location_mesh
+    awsVpcCidr: 10.10.0.0/16
+  addons:
+    keda: true
+
+Examples:
+  # Create or update cluster.
+  cast cluster apply -f cluster.yaml
+
+  # Apply changes without confirmation and wait until new cluster is ready.
+  cast cluster apply -f cluster.yaml --yes --wait
+
+  # Update cluster and delete nodes which are not in the spec.
+  cast cluster apply -f cluster.yaml --prune
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleClusterApply(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVarP(&opts.File, "file", "f", "", "path to cluster spec file, use - to read from stdin")
+	cmd.PersistentFlags().BoolVar(&opts.Wait, "wait", false, "wait until cluster creation finishes, supported only when cluster is created, eg. --wait=true")
+	cmd.PersistentFlags().BoolVar(&opts.Prune, "prune", false, "delete existing nodes which are not in the spec")
+	cmd.PersistentFlags().BoolVarP(&opts.Confirm, "yes", "y", false, "apply changes without confirmation")
+	cmd.MarkPersistentFlagRequired("file")
+	return cmd
+}
+
+func handleClusterApply(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts clusterApplyOptions) error {
+	ctx := cmd.Context()
+	spec, err := readClusterSpec(opts.File)
+	if err != nil {
+		return err
+	}
+
+	plan, cluster, err := planClusterSpec(ctx, api, spec, opts.Prune)
+	if err != nil {
+		return err
+	}
+
+	printClusterPlan(cmd.OutOrStdout(), plan)
+	if plan.empty() {
+		return nil
+	}
+	if opts.Wait && plan.Create == nil {
+		return fmt.Errorf("--wait is supported only when cluster is created, cluster %s already exists", cluster.Name)
+	}
+
+	if !opts.Confirm {
+		if err := survey.AskOne(&survey.Confirm{
+			Message: "Apply these changes?",
+		}, &opts.Confirm); err != nil {
+			return err
+		}
+	}
+
+	if !opts.Confirm {
+		log.Info("Cluster apply canceled")
+		return nil
+	}
+
+	if plan.Create != nil {
+		cluster, err := api.CreateNewCluster(ctx, *plan.Create)
+		if err != nil {
+			return err
+		}
+		if !opts.Wait {
+			log.Infof("Cluster creation is now in progress. Check status by running 'cast cluster get %s'", cluster.Name)
+			return nil
+		}
+		log.Info("Cluster creation is now in progress. It is safe to close this terminal.")
+		if err := waitClusterCreatedWithProgress(ctx, log, api, cluster.Id); err != nil {
+			return err
+		}
+		log.Infof("Cluster is ready. Check status by running 'cast cluster get %s'", cluster.Name)
+		return nil
+	}
+
+	clusterID := sdk.ClusterId(cluster.Id)
+	if plan.Update != nil {
+		if _, err := api.UpdateCluster(ctx, clusterID, *plan.Update); err != nil {
+			return err
+		}
+		log.Infof("Cluster %s credentials and network updated", cluster.Name)
+	}
+
+	if len(plan.AddNodes) > 0 || len(plan.DeleteNodes) > 0 {
+		req := sdk.UpdateNodeListJSONRequestBody{}
+		if len(plan.AddNodes) > 0 {
+			req.Add = &plan.AddNodes
+		}
+		if len(plan.DeleteNodes) > 0 {
+			deleted := make([]sdk.DeletedNode, len(plan.DeleteNodes))
+			for i, node := range plan.DeleteNodes {
+				if node.Id == nil {
+					return fmt.Errorf("node %s can't be deleted, it has no id", nodeName(node))
+				}
+				deleted[i] = sdk.DeletedNode{Id: *node.Id}
+			}
+			req.Delete = &deleted
+		}
+		if _, err := api.UpdateNodeList(ctx, clusterID, req); err != nil {
+			return err
+		}
+		log.Infof("Cluster %s nodes update is now in progress. Check status by running 'cast node list -c %s'", cluster.Name, cluster.Name)
+	}
+	return nil
+}
+
+// planClusterSpec loads live cluster state by spec name and builds changes plan. Deleted clusters are ignored.
+// Returned cluster is nil if it doesn't exist yet.
+func planClusterSpec(ctx context.Context, api client.Interface, spec *clusterSpec, prune bool) (*clusterPlan, *sdk.KubernetesCluster, error) {
+	lists := &clusterCreationSelectLists{}
+	if err := lists.load(ctx, api); err != nil {
+		return nil, nil, err
+	}
+
+	clusters, err := api.ListKubernetesClusters(ctx, &sdk.ListKubernetesClustersParams{})
+	if err != nil {
+		return nil, nil, err
+	}
+	var cluster *sdk.KubernetesCluster
+	for i := range clusters {
+		if clusters[i].Status != "deleted" && strings.EqualFold(clusters[i].Name, spec.Name) {
+			cluster = &clusters[i]
+			break
+		}
+	}
+
+	var nodes []sdk.Node
+	if cluster != nil {
+		nodes, err = api.ListClusterNodes(ctx, sdk.ClusterId(cluster.Id))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	plan, err := buildClusterPlan(lists, spec, cluster, nodes, prune)
+	if err != nil {
+		return nil, nil, err
+	}
+	return plan, cluster, nil
+}
@@ -0,0 +1,168 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+func newClusterArchiveCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	var confirm bool
+	cmd := &cobra.Command{
+		Use:   "archive <cluster_name_or_id>",
+		Short: "Archive deleted cluster",
+		Long: `
+Archived clusters are no longer listed by 'cast cluster list --include-deleted'.
+Only deleted clusters can be archived, cluster name is matched against deleted clusters only.
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleClusterArchive(cmd, log, api, confirm); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().BoolVarP(&confirm, "yes", "y", false, "confirm cluster archive")
+	return cmd
+}
+
+func handleClusterArchive(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, confirm bool) error {
+	ctx := cmd.Context()
+	var cluster *sdk.KubernetesCluster
+	var err error
+	if args := cmd.Flags().Args(); len(args) > 0 {
+		cluster, err = getDeletedCluster(ctx, api, args[0])
+	} else {
+		cluster, err = selectDeletedCluster(ctx, api)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		if err := survey.AskOne(&survey.Confirm{
+			Message: "Are you sure?",
+		}, &confirm); err != nil {
+			return err
+		}
+	}
+
+	if !confirm {
+		log.Info("Cluster archive canceled")
+		return nil
+	}
+
+	if err := api.ArchiveCluster(ctx, sdk.ClusterId(cluster.Id)); err != nil {
+		return err
+	}
+	log.Infof("Cluster %s archived", cluster.Name)
+	return nil
+}
+
+// getDeletedCluster gets deleted cluster by name or ID. Live clusters are never returned, even if deleted cluster
+// name is reused by them.
+func getDeletedCluster(ctx context.Context, api client.Interface, clusterNameOrID string) (*sdk.KubernetesCluster, error) {
+	if uuidID, err := uuid.Parse(clusterNameOrID); err == nil {
+		cluster, err := api.GetCluster(ctx, sdk.ClusterId(uuidID.String()))
+		if err != nil {
+			return nil, err
+		}
+		if cluster.Status != "deleted" {
+			return nil, fmt.Errorf("cluster %s status is %s, only deleted clusters can be archived", cluster.Name, cluster.Status)
+		}
+		return cluster, nil
+	}
+
+	clusters, err := listDeletedClusters(ctx, api)
+	if err != nil {
+		return nil, err
+	}
+	var res *sdk.KubernetesCluster
+	for i := range clusters {
+		if !strings.EqualFold(clusters[i].Name, clusterNameOrID) {
+			continue
+		}
+		if res != nil {
+			return nil, fmt.Errorf("multiple deleted clusters named %s found, pass cluster ID instead", clusterNameOrID)
+		}
+		res = &clusters[i]
+	}
+	if res == nil {
+		return nil, fmt.Errorf("deleted cluster %s not found", clusterNameOrID)
+	}
+	return res, nil
+}
+
+// selectDeletedCluster shows interactive deleted clusters selection list and returns selected cluster.
+func selectDeletedCluster(ctx context.Context, api client.Interface) (*sdk.KubernetesCluster, error) {
+	items, err := listDeletedClusters(ctx, api)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.New("no deleted clusters found")
+	}
+	// Deleted clusters names can repeat, so IDs are shown too.
+	displayName := func(item sdk.KubernetesCluster) string {
+		return fmt.Sprintf("%s (%s)", item.Name, item.Id)
+	}
+	selectList := make([]string, len(items))
+	for i, item := range items {
+		selectList[i] = displayName(item)
+	}
+
+	var selected string
+	prompt := &survey.Select{
+		Message: "Select deleted cluster:",
+		Options: selectList,
+		Default: selectList[0],
+	}
+	if err := survey.AskOne(prompt, &selected, survey.WithValidator(survey.Required)); err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if displayName(item) == selected {
+			return &item, nil
+		}
+	}
+	return nil, errors.New("cluster not found")
+}
+
+func listDeletedClusters(ctx context.Context, api client.Interface) ([]sdk.KubernetesCluster, error) {
+	clusters, err := api.ListKubernetesClusters(ctx, &sdk.ListKubernetesClustersParams{})
+	if err != nil {
+		return nil, err
+	}
+	var res []sdk.KubernetesCluster
+	for _, cluster := range clusters {
+		if cluster.Status == "deleted" {
+			res = append(res, cluster)
+		}
+	}
+	return res, nil
+}
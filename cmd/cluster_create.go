@@ -21,9 +21,8 @@ import (
 	"errors"
 	"fmt"
 	"net"
-	"sort"
+	"strconv"
 	"strings"
-	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/sirupsen/logrus"
@@ -42,6 +41,8 @@ type clusterCreateOptions struct {
 	VPN                string   `survey:"vpn"`
 	Nodes              []string
 	Wait               bool
+	Estimate           bool
+	Confirm            bool
 	AWSVPCCidr         string
 	PrivateWorkerNodes bool
 	GCPVPCCidr         string
@@ -91,8 +92,18 @@ Examples:
     --node=aws-worker-small \
     --node=gcp-worker-medium \
     --node=do-worker-large \
+    --node=aws-worker-large:spot \
     --vpn=wireguard_full_mesh \
     --wait
+
+  # Show estimated cluster price and confirm before creating it.
+  cast cluster create \
+    --name=my-demo-cluster \
+    --region=eu-central \
+    --credentials=aws,gcp \
+    --configuration=ha \
+    --vpn=wireguard_cross_location_mesh \
+    --estimate
 `,
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := handleCreateCluster(cmd, log, api, opts); err != nil {
@@ -103,7 +114,7 @@ Examples:
 	cmd.PersistentFlags().StringVar(&opts.Name, "name", "", "cluster name, eg. --name=my-demo-cluster")
 	cmd.PersistentFlags().StringVar(&opts.Region, "region", cfg.DefaultRegion, "region in which cluster will be created, eg. --region=eu-central")
 	cmd.PersistentFlags().StringSliceVar(&opts.Credentials, "credentials", []string{}, "cloud credentials names, eg. --credentials=aws, --credentials=gcp")
-	cmd.PersistentFlags().StringSliceVar(&opts.Nodes, "node", []string{}, "nodes configuration, eg. --node=aws-master-medium --node=gcp-worker-small")
+	cmd.PersistentFlags().StringSliceVar(&opts.Nodes, "node", []string{}, "nodes configuration, eg. --node=aws-master-medium --node=gcp-worker-small --node=aws-worker-large:spot")
 	cmd.PersistentFlags().StringVar(&opts.Configuration, "configuration", clusterConfigurationBasic, "quick cluster nodes configuration, available values: basic,ha")
 	cmd.PersistentFlags().StringVar(&opts.VPN, "vpn", "", "virtual private network type between clouds, available values: cloud_provider, wireguard_cross_location_mesh, wireguard_full_mesh")
 	cmd.PersistentFlags().StringVar(&opts.AWSVPCCidr, "aws-vpc-cidr", "", "optional custom AWS VPC IPv4 CIDR, eg. --aws-vpc-cidr=10.10.0.0/16")
@@ -112,6 +123,8 @@ Examples:
 	cmd.PersistentFlags().StringVar(&opts.AzureVPCCidr, "azure-vpc-cidr", "", "optional custom AZURE VPC IPv4 CIDR, eg. --azure-vpc-cidr=10.20.0.0/16")
 	cmd.PersistentFlags().StringVar(&opts.DOVPCCidr, "do-vpc-cidr", "", "optional custom DO IPv4 CIDR, eg. --do-vpc-cidr=10.100.0.0/16")
 	cmd.PersistentFlags().BoolVar(&opts.Wait, "wait", false, "wait until operation finishes, eg. --wait=true")
+	cmd.PersistentFlags().BoolVar(&opts.Estimate, "estimate", false, "show estimated cluster price and ask for confirmation before creating cluster")
+	cmd.PersistentFlags().BoolVarP(&opts.Confirm, "yes", "y", false, "confirm cluster creation after price estimate")
 	return cmd
 }
 
@@ -123,6 +136,7 @@ func handleCreateCluster(cmd *cobra.Command, log logrus.FieldLogger, api client.
 		if err != nil {
 			return err
 		}
+		opts.Estimate = true
 	} else {
 		req, err = parseDeclarativeClusterForm(cmd.Context(), api, opts)
 		if err != nil {
@@ -130,6 +144,17 @@ func handleCreateCluster(cmd *cobra.Command, log logrus.FieldLogger, api client.
 		}
 	}
 
+	if opts.Estimate {
+		confirmed, err := confirmClusterPrice(cmd, api, req, opts.Confirm)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			log.Info("Cluster create canceled")
+			return nil
+		}
+	}
+
 	cluster, err := api.CreateNewCluster(cmd.Context(), *req)
 	if err != nil {
 		return err
@@ -148,45 +173,16 @@ func handleCreateCluster(cmd *cobra.Command, log logrus.FieldLogger, api client.
 	return nil
 }
 
-func waitClusterCreatedWithProgress(ctx context.Context, log logrus.FieldLogger, api client.Interface, clusterID string) (err error) {
-	written := make(map[string]struct{})
-
-	for {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		case <-time.After(10 * time.Second):
-			// Check if cluster is ready.
-			cluster, err := api.GetCluster(ctx, sdk.ClusterId(clusterID))
-			if err != nil {
-				log.Warn(err)
-				continue
-			}
-			if cluster.Status == "ready" {
-				return nil
-			}
-
-			// Print feedback events.
-			events, err := api.GetClusterFeedbackEvents(ctx, sdk.ClusterId(clusterID))
-			if err != nil {
-				log.Warn(err)
-				continue
-			}
-			sort.Slice(events, func(i, j int) bool {
-				return events[i].CreatedAt.Before(events[j].CreatedAt)
-			})
-			for _, e := range events {
-				if _, ok := written[e.Id]; !ok {
-					logFn := log.Infof
-					if e.Severity == "error" {
-						logFn = log.Errorf
-					}
-					logFn("%s %s", e.CreatedAt.Format(time.RFC3339), e.Message)
-					written[e.Id] = struct{}{}
-				}
-			}
+func waitClusterCreatedWithProgress(ctx context.Context, log logrus.FieldLogger, api client.Interface, clusterID string) error {
+	return waitClusterWithProgress(ctx, log, api, newClusterEventsPrinter(log, api, clusterID), "creation", 0, func(cluster *sdk.KubernetesCluster) (bool, error) {
+		if cluster == nil {
+			return false, fmt.Errorf("cluster %s not found", clusterID)
 		}
-	}
+		if err := clusterStatusError(cluster); err != nil {
+			return false, err
+		}
+		return cluster.Status == "ready", nil
+	})
 }
 
 func parseDeclarativeClusterForm(ctx context.Context, api client.Interface, opts clusterCreateOptions) (*sdk.CreateNewClusterJSONRequestBody, error) {
@@ -425,10 +421,24 @@ func toAPINodesFromFlags(nodes []string) ([]sdk.Node, error) {
 	return res, nil
 }
 
+// parseNode parses node in cloud-role-shape[:spot[=max_price]] format, eg. aws-worker-large:spot.
 func parseNode(n string) (sdk.Node, error) {
-	p := strings.Split(n, "-")
-	if len(p) < 2 {
-		return sdk.Node{}, fmt.Errorf("unknown node format %q, it should contain cloud, type and shape, eg. --node=aws-master-medium or --node=aws-worker-small", n)
+	spec, lifecycle := n, ""
+	if i := strings.Index(n, ":"); i >= 0 {
+		spec, lifecycle = n[:i], n[i+1:]
+	}
+	var instanceType string
+	if i := strings.Index(spec, "@"); i >= 0 {
+		spec, instanceType = spec[:i], strings.TrimSpace(spec[i+1:])
+		if instanceType == "" {
+			return sdk.Node{}, fmt.Errorf("empty node instance type in %q, eg. --node=aws-worker@m5.xlarge", n)
+		}
+	}
+
+	// Shape can be omitted when instance type is set.
+	p := strings.Split(spec, "-")
+	if len(p) != 3 && (len(p) != 2 || instanceType == "") {
+		return sdk.Node{}, fmt.Errorf("unknown node format %q, it should contain cloud, type and shape or instance type, eg. --node=aws-master-medium, --node=aws-worker-small:spot or --node=aws-worker@m5.xlarge", n)
 	}
 
 	cloud := strings.TrimSpace(p[0])
@@ -449,20 +459,64 @@ func parseNode(n string) (sdk.Node, error) {
 		return sdk.Node{}, fmt.Errorf("unknown node role %q, allowed values: master, worker", role)
 	}
 
-	shape := strings.TrimSpace(p[2])
-	switch shape {
-	case "small":
-	case "medium":
-	case "large":
-	default:
-		return sdk.Node{}, fmt.Errorf("unknown node shape %q, allowed values: small, medium, large", shape)
+	var shape string
+	if len(p) == 3 {
+		shape = strings.TrimSpace(p[2])
+		switch shape {
+		case "small":
+		case "medium":
+		case "large":
+		default:
+			return sdk.Node{}, fmt.Errorf("unknown node shape %q, allowed values: small, medium, large", shape)
+		}
 	}
 
-	return sdk.Node{
-		Cloud: sdk.CloudType(cloud),
-		Role:  sdk.NodeType(role),
-		Shape: sdk.NodeShape(shape),
-	}, nil
+	node := sdk.Node{
+		Cloud:        sdk.CloudType(cloud),
+		Role:         sdk.NodeType(role),
+		Shape:        sdk.NodeShape(shape),
+		InstanceType: instanceType,
+	}
+
+	if lifecycle != "" {
+		p := strings.SplitN(lifecycle, "=", 2)
+		if strings.TrimSpace(p[0]) != "spot" {
+			return sdk.Node{}, fmt.Errorf("unknown node lifecycle %q, allowed values: spot, spot=<max_price>", lifecycle)
+		}
+		var maxPrice string
+		if len(p) == 2 {
+			maxPrice = strings.TrimSpace(p[1])
+		}
+		spot, err := toNodeSpotConfig(cloud, true, maxPrice)
+		if err != nil {
+			return sdk.Node{}, err
+		}
+		node.SpotConfig = spot
+	}
+
+	return node, nil
+}
+
+// toNodeSpotConfig returns node spot config or nil for on-demand node. Max price is supported on aws only.
+func toNodeSpotConfig(cloud string, spot bool, maxPrice string) (*sdk.NodeSpotConfig, error) {
+	if !spot {
+		if maxPrice != "" {
+			return nil, errors.New("spot max price can be set only for spot nodes")
+		}
+		return nil, nil
+	}
+	cfg := &sdk.NodeSpotConfig{IsSpot: true}
+	if maxPrice == "" {
+		return cfg, nil
+	}
+	if cloud != "aws" {
+		return nil, fmt.Errorf("spot max price is supported only on aws, got cloud %q", cloud)
+	}
+	if v, err := strconv.ParseFloat(maxPrice, 64); err != nil || v <= 0 {
+		return nil, fmt.Errorf("invalid spot max price %q, it should be positive hourly price, eg. 0.05", maxPrice)
+	}
+	cfg.Price = &maxPrice
+	return cfg, nil
 }
 
 func toAPINodesFromConfiguration(selectedClouds []string, clusterConfigurationName string) []sdk.Node {
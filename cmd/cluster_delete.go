@@ -25,7 +25,10 @@ import (
 	"github.com/castai/cli/pkg/client/sdk"
 )
 
-var flagDeleteClusterConfirm bool
+var (
+	flagDeleteClusterConfirm bool
+	flagDeleteClusterWait    bool
+)
 
 func newClusterDeleteCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
 	cmd := &cobra.Command{
@@ -38,6 +41,7 @@ func newClusterDeleteCmd(log logrus.FieldLogger, api client.Interface) *cobra.Co
 		},
 	}
 	cmd.PersistentFlags().BoolVarP(&flagDeleteClusterConfirm, "yes", "y", false, "confirm cluster deletion")
+	cmd.PersistentFlags().BoolVar(&flagDeleteClusterWait, "wait", false, "wait until cluster is deleted, eg. --wait=true")
 
 	return cmd
 }
@@ -61,11 +65,35 @@ func handleDeleteCluster(cmd *cobra.Command, log logrus.FieldLogger, api client.
 		return nil
 	}
 
-	err = api.DeleteCluster(cmd.Context(), sdk.ClusterId(cluster.Id))
+	ctx := cmd.Context()
+	events := newClusterEventsPrinter(log, api, cluster.Id)
+	if flagDeleteClusterWait {
+		events.skipExisting(ctx)
+	}
+	err = api.DeleteCluster(ctx, sdk.ClusterId(cluster.Id))
 	if err != nil {
 		return err
 	}
 
-	log.Info("Cluster deletion is now in progress")
+	if !flagDeleteClusterWait {
+		log.Info("Cluster deletion is now in progress")
+		return nil
+	}
+
+	log.Info("Cluster deletion is now in progress. It is safe to close this terminal.")
+	err = waitClusterWithProgress(ctx, log, api, events, "deletion", clusterChangeWaitTimeout, func(cluster *sdk.KubernetesCluster) (bool, error) {
+		// Deleted cluster may be no longer returned at all.
+		if cluster == nil {
+			return true, nil
+		}
+		if err := clusterStatusError(cluster); err != nil {
+			return false, err
+		}
+		return cluster.Status == "deleted", nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof("Cluster %s deleted", cluster.Name)
 	return nil
 }
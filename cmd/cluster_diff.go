@@ -0,0 +1,90 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+)
+
+// clusterDiffChangesExitCode is returned by cluster diff when live cluster differs from the spec.
+const clusterDiffChangesExitCode = 2
+
+func newClusterDiffCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	var (
+		file  string
+		prune bool
+	)
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show changes which cluster apply would make",
+		Long: `
+Compares cluster spec file with the live cluster and prints planned changes.
+
+Exit codes:
+  0 - cluster is up to date
+  1 - error
+  2 - cluster will be created or updated
+
+Examples:
+  # Fail CI job if cluster differs from the spec.
+  cast cluster diff -f cluster.yaml
+
+  # Also show nodes which cluster apply --prune would delete.
+  cast cluster diff -f cluster.yaml --prune
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			changed, err := handleClusterDiff(cmd, api, file, prune)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if changed {
+				os.Exit(clusterDiffChangesExitCode)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVarP(&file, "file", "f", "", "path to cluster spec file, use - to read from stdin")
+	cmd.PersistentFlags().BoolVar(&prune, "prune", false, "include deletion of existing nodes which are not in the spec, same as cluster apply --prune")
+	cmd.MarkPersistentFlagRequired("file")
+	return cmd
+}
+
+func handleClusterDiff(cmd *cobra.Command, api client.Interface, file string, prune bool) (bool, error) {
+	spec, err := readClusterSpec(file)
+	if err != nil {
+		return false, err
+	}
+
+	plan, _, err := planClusterSpec(cmd.Context(), api, spec, prune)
+	if err != nil {
+		return false, err
+	}
+
+	out := cmd.OutOrStdout()
+	printClusterPlan(out, plan)
+	if plan.empty() {
+		return false, nil
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, plan.summary())
+	return true, nil
+}
@@ -0,0 +1,228 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+const (
+	clusterExportFormatYAML  = "yaml"
+	clusterExportFormatJSON  = "json"
+	clusterExportFormatFlags = "flags"
+)
+
+type clusterExportOptions struct {
+	Format string
+	Name   string
+	Region string
+}
+
+func newClusterExportCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := clusterExportOptions{}
+	cmd := &cobra.Command{
+		Use:   "export <cluster_name_or_id>",
+		Short: "Export cluster as spec file or cluster create flags",
+		Long: `
+Exports live cluster nodes, region, credentials, network and addons configuration in the format
+accepted by 'cast cluster apply' or 'cast cluster create'.
+
+Examples:
+  # Save cluster spec to file.
+  cast cluster export my-demo-cluster > cluster.yaml
+
+  # Clone cluster into another region.
+  cast cluster export my-demo-cluster --name=my-demo-cluster-us --region=us-east --format=flags
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleClusterExport(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVar(&opts.Format, "format", clusterExportFormatYAML, "export format, available values: yaml, json, flags")
+	cmd.PersistentFlags().StringVar(&opts.Name, "name", "", "override cluster name in exported spec")
+	cmd.PersistentFlags().StringVar(&opts.Region, "region", "", "override cluster region in exported spec")
+	return cmd
+}
+
+func handleClusterExport(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts clusterExportOptions) error {
+	ctx := cmd.Context()
+	cluster, err := getClusterFromArgs(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	nodes, err := api.ListClusterNodes(ctx, sdk.ClusterId(cluster.Id))
+	if err != nil {
+		return err
+	}
+
+	credentials, err := api.ListCloudCredentials(ctx)
+	if err != nil {
+		return err
+	}
+
+	spec, err := toClusterSpec(log, cluster, nodes, credentials)
+	if err != nil {
+		return err
+	}
+	if opts.Name != "" {
+		spec.Name = opts.Name
+	}
+	if opts.Region != "" {
+		spec.Region = opts.Region
+	}
+
+	out := cmd.OutOrStdout()
+	switch opts.Format {
+	case clusterExportFormatYAML:
+		b, err := yaml.Marshal(spec)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(out, string(b))
+	case clusterExportFormatJSON:
+		b, err := json.MarshalIndent(spec, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(b))
+	case clusterExportFormatFlags:
+		if spec.Addons.Keda {
+			log.Warn("Addons can't be passed as cluster create flags, use yaml or json format to keep them")
+		}
+		fmt.Fprintln(out, "cast cluster create \\")
+		fmt.Fprintln(out, "  "+strings.Join(clusterSpecFlags(spec), " \\\n  "))
+	default:
+		usagef(cmd, "unknown export format %q, available values: yaml, json, flags", opts.Format)
+	}
+	return nil
+}
+
+// toClusterSpec converts live cluster to the cluster spec. It fails if any node can't be expressed in the spec.
+func toClusterSpec(log logrus.FieldLogger, cluster *sdk.KubernetesCluster, nodes []sdk.Node, credentials []sdk.CloudCredentials) (*clusterSpec, error) {
+	spec := &clusterSpec{
+		APIVersion: clusterSpecAPIVersion,
+		Kind:       clusterSpecKind,
+		Name:       cluster.Name,
+		Region:     cluster.Region.Name,
+	}
+
+	for _, id := range cluster.CloudCredentialsIDs {
+		name := id
+		for _, c := range credentials {
+			if c.Id == id {
+				name = c.Name
+				break
+			}
+		}
+		if name == id {
+			log.Warnf("Cloud credentials %s not found, using ID instead of name", id)
+		}
+		spec.Credentials = append(spec.Credentials, name)
+	}
+
+	nodes = append([]sdk.Node(nil), nodes...)
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].Role != nodes[j].Role {
+			// Keep masters first.
+			return nodes[i].Role == sdk.NodeType_master
+		}
+		return nodeName(nodes[i]) < nodeName(nodes[j])
+	})
+	for _, node := range nodes {
+		if phase := nodePhase(node); phase == "deleting" || phase == "deleted" {
+			continue
+		}
+		nodeSpec := nodeSpecString(node)
+		if _, err := parseNode(nodeSpec); err != nil {
+			return nil, fmt.Errorf("node %s can't be expressed in cluster spec: %w", nodeName(node), err)
+		}
+		spec.Nodes = append(spec.Nodes, nodeSpec)
+	}
+
+	if network := cluster.Network; network != nil {
+		if vpn := vpnTypeName(network.Vpn); vpn != "none" {
+			spec.Network.VPN = vpn
+		}
+		spec.Network.PrivateWorkerNodes = network.PrivateWorkerNodes
+		if network.Aws != nil {
+			spec.Network.AWSVPCCidr = network.Aws.VpcCidr
+		}
+		if network.Gcp != nil {
+			spec.Network.GCPVPCCidr = network.Gcp.VpcCidr
+		}
+		if network.Azure != nil {
+			spec.Network.AzureVPCCidr = network.Azure.VpcCidr
+		}
+		if network.Do != nil {
+			spec.Network.DOVPCCidr = network.Do.VpcCidr
+		}
+	}
+
+	if cluster.Addons != nil && cluster.Addons.Keda != nil {
+		spec.Addons.Keda = cluster.Addons.Keda.Enabled
+	}
+
+	return spec, nil
+}
+
+// clusterSpecFlags converts cluster spec to cluster create command flags.
+func clusterSpecFlags(spec *clusterSpec) []string {
+	flags := []string{
+		"--name=" + spec.Name,
+		"--region=" + spec.Region,
+		"--credentials=" + strings.Join(spec.Credentials, ","),
+	}
+	if spec.Configuration != "" {
+		flags = append(flags, "--configuration="+spec.Configuration)
+	}
+	for _, node := range spec.Nodes {
+		flags = append(flags, "--node="+node)
+	}
+	network := spec.Network
+	if network.VPN != "" {
+		flags = append(flags, "--vpn="+network.VPN)
+	}
+	if network.PrivateWorkerNodes {
+		flags = append(flags, "--private-worker-nodes")
+	}
+	if network.AWSVPCCidr != "" {
+		flags = append(flags, "--aws-vpc-cidr="+network.AWSVPCCidr)
+	}
+	if network.GCPVPCCidr != "" {
+		flags = append(flags, "--gcp-vpc-cidr="+network.GCPVPCCidr)
+	}
+	if network.AzureVPCCidr != "" {
+		flags = append(flags, "--azure-vpc-cidr="+network.AzureVPCCidr)
+	}
+	if network.DOVPCCidr != "" {
+		flags = append(flags, "--do-vpc-cidr="+network.DOVPCCidr)
+	}
+	return flags
+}
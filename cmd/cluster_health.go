@@ -0,0 +1,195 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/jedib0t/go-pretty/v6/text"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+)
+
+// clusterHealthUnhealthyExitCode is returned by cluster health when any of cluster components is not healthy.
+const clusterHealthUnhealthyExitCode = 2
+
+type clusterHealthReport struct {
+	Healthy    bool                        `json:"healthy"`
+	Kubernetes sdk.ClusterHealthKubernetes `json:"kubernetes"`
+	Cilium     sdk.ClusterHealthCilium     `json:"cilium"`
+	NodesMsg   string                      `json:"nodesMsg,omitempty"`
+	Nodes      []clusterHealthNode         `json:"nodes"`
+}
+
+type clusterHealthNode struct {
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name"`
+	Cloud    string `json:"cloud,omitempty"`
+	Role     string `json:"role,omitempty"`
+	HostIP   string `json:"hostIp"`
+	PublicIP string `json:"publicIp,omitempty"`
+	State    string `json:"state"`
+}
+
+func newClusterHealthCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "health <cluster_name_or_id>",
+		Short: "Show cluster health",
+		Long: `
+Shows Kubernetes, Cilium and nodes health.
+
+Exit codes:
+  0 - cluster is healthy
+  1 - error
+  2 - cluster is not healthy
+
+Examples:
+  # Use as monitoring probe.
+  cast cluster health my-cluster -o json
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			healthy, err := handleClusterHealth(cmd, api)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if !healthy {
+				os.Exit(clusterHealthUnhealthyExitCode)
+			}
+		},
+	}
+	command.AddJSONOutput(cmd)
+	return cmd
+}
+
+func handleClusterHealth(cmd *cobra.Command, api client.Interface) (bool, error) {
+	cluster, err := getClusterFromArgs(cmd, api)
+	if err != nil {
+		return false, err
+	}
+
+	ctx := cmd.Context()
+	health, err := api.GetClusterHealth(ctx, sdk.ClusterId(cluster.Id))
+	if err != nil {
+		return false, err
+	}
+	nodes, err := api.ListClusterNodes(ctx, sdk.ClusterId(cluster.Id))
+	if err != nil {
+		return false, err
+	}
+
+	report := toClusterHealthReport(health, nodes)
+	if command.OutputJSON() {
+		command.PrintOutput(report)
+		return report.Healthy, nil
+	}
+
+	printClusterHealth(cmd.OutOrStdout(), report)
+	return report.Healthy, nil
+}
+
+// toClusterHealthReport joins health nodes with cluster nodes by node private or public IP.
+func toClusterHealthReport(health *sdk.ClusterHealth, nodes []sdk.Node) *clusterHealthReport {
+	report := &clusterHealthReport{
+		Healthy:    isHealthyState(health.Kubernetes.State) && isHealthyState(health.Cilium.State),
+		Kubernetes: health.Kubernetes,
+		Cilium:     health.Cilium,
+		NodesMsg:   health.Nodes.Msg,
+		Nodes:      make([]clusterHealthNode, 0, len(health.Nodes.Items)),
+	}
+
+	for _, item := range health.Nodes.Items {
+		node := clusterHealthNode{
+			Name:   item.Name,
+			HostIP: item.HostIp,
+			State:  item.State,
+		}
+		for _, n := range nodes {
+			if n.Network == nil || (n.Network.PrivateIp != item.HostIp && n.Network.PublicIp != item.HostIp) {
+				continue
+			}
+			node.ID = nodeValueString(n.Id)
+			node.Name = nodeValueString(n.Name)
+			node.Cloud = string(n.Cloud)
+			node.Role = string(n.Role)
+			node.PublicIP = n.Network.PublicIp
+			break
+		}
+		if !isHealthyState(item.State) {
+			report.Healthy = false
+		}
+		report.Nodes = append(report.Nodes, node)
+	}
+	return report
+}
+
+func isHealthyState(state string) bool {
+	return strings.EqualFold(state, "healthy") || strings.EqualFold(state, "ok")
+}
+
+// nodesHealthState returns aggregated state of all nodes.
+func nodesHealthState(nodes []clusterHealthNode) string {
+	for _, node := range nodes {
+		if !isHealthyState(node.State) {
+			return "unhealthy"
+		}
+	}
+	return "healthy"
+}
+
+func colorHealthState(state string) string {
+	if isHealthyState(state) {
+		return text.FgGreen.Sprint(state)
+	}
+	return text.FgRed.Sprint(state)
+}
+
+func printClusterHealth(out io.Writer, report *clusterHealthReport) {
+	t := table.NewWriter()
+	t.SetStyle(command.DefaultTableStyle)
+	t.SetOutputMirror(out)
+	t.AppendHeader(table.Row{"Component", "State", "Message"})
+	t.AppendRow(table.Row{"Kubernetes", colorHealthState(report.Kubernetes.State), report.Kubernetes.Msg})
+	t.AppendRow(table.Row{"Cilium", colorHealthState(report.Cilium.State), report.Cilium.Msg})
+	t.AppendRow(table.Row{"Nodes", colorHealthState(nodesHealthState(report.Nodes)), report.NodesMsg})
+	t.Render()
+
+	fmt.Fprintln(out)
+	t = table.NewWriter()
+	t.SetStyle(command.DefaultTableStyle)
+	t.SetOutputMirror(out)
+	t.AppendHeader(table.Row{"ID", "Name", "Cloud", "Role", "Host_IP", "Public_IP", "State"})
+	for _, node := range report.Nodes {
+		t.AppendRow(table.Row{
+			node.ID,
+			node.Name,
+			node.Cloud,
+			node.Role,
+			node.HostIP,
+			node.PublicIP,
+			colorHealthState(node.State),
+		})
+	}
+	t.Render()
+}
@@ -0,0 +1,166 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+)
+
+func newClusterHistoryCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	var since string
+	cmd := &cobra.Command{
+		Use:   "history <cluster_name_or_id>",
+		Short: "Show cluster audit log",
+		Long: `
+Shows cluster audit log events from the oldest to the newest. Nested event metadata
+is flattened to key=value pairs, eg. node.cloud=aws.
+
+Examples:
+  # Show cluster events of the last 7 days.
+  cast cluster history my-cluster --since=7d
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleClusterHistory(cmd, api, since); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVar(&since, "since", "", "show events after given time, eg. --since=2021-01-31 or --since=24h")
+	command.AddJSONOutput(cmd)
+	return cmd
+}
+
+func handleClusterHistory(cmd *cobra.Command, api client.Interface, since string) error {
+	var from time.Time
+	if since != "" {
+		var err error
+		from, err = parseTimeFlag(since)
+		if err != nil {
+			return err
+		}
+	}
+
+	cluster, err := getClusterFromArgs(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	events, err := api.GetClusterAuditLog(cmd.Context(), sdk.ClusterId(cluster.Id))
+	if err != nil {
+		return err
+	}
+	events = filterAuditLogEvents(events, from)
+
+	if command.OutputJSON() {
+		command.PrintOutput(events)
+		return nil
+	}
+
+	printClusterHistoryTable(cmd.OutOrStdout(), events)
+	return nil
+}
+
+// filterAuditLogEvents returns events created after given time sorted from the oldest to the newest.
+func filterAuditLogEvents(events []sdk.AuditLogEvent, from time.Time) []sdk.AuditLogEvent {
+	res := make([]sdk.AuditLogEvent, 0, len(events))
+	for _, e := range events {
+		if e.CreatedAt.Before(from) {
+			continue
+		}
+		res = append(res, e)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].CreatedAt.Before(res[j].CreatedAt)
+	})
+	return res
+}
+
+func printClusterHistoryTable(out io.Writer, events []sdk.AuditLogEvent) {
+	t := table.NewWriter()
+	t.SetStyle(command.DefaultTableStyle)
+	t.SetOutputMirror(out)
+	t.AppendHeader(table.Row{"Time", "Operation", "Metadata"})
+	for _, e := range events {
+		t.AppendRow(table.Row{
+			e.CreatedAt.Format(time.RFC3339),
+			e.Operation,
+			formatAuditLogMetadata(e.Metadata),
+		})
+	}
+	t.Render()
+}
+
+// formatAuditLogMetadata flattens metadata to space separated key=value pairs sorted by key.
+func formatAuditLogMetadata(metadata map[string]interface{}) string {
+	flat := map[string]string{}
+	flattenAuditLogMetadata(flat, "", metadata)
+
+	keys := make([]string, 0, len(flat))
+	for k := range flat {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		v := flat[k]
+		if v == "" || strings.ContainsAny(v, " \t\n\"=") {
+			v = strconv.Quote(v)
+		}
+		pairs[i] = fmt.Sprintf("%s=%s", k, v)
+	}
+	return strings.Join(pairs, " ")
+}
+
+// flattenAuditLogMetadata writes nested maps as dot separated keys and slices as indexed keys, eg. node.cloud, labels.0.
+func flattenAuditLogMetadata(res map[string]string, prefix string, v interface{}) {
+	key := func(k string) string {
+		if prefix == "" {
+			return k
+		}
+		return prefix + "." + k
+	}
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			flattenAuditLogMetadata(res, key(k), item)
+		}
+	case []interface{}:
+		for i, item := range v {
+			flattenAuditLogMetadata(res, key(strconv.Itoa(i)), item)
+		}
+	case nil:
+		if prefix != "" {
+			res[prefix] = ""
+		}
+	default:
+		res[prefix] = fmt.Sprint(v)
+	}
+}
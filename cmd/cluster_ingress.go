@@ -0,0 +1,101 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+)
+
+func newClusterIngressCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ingress <cluster_name_or_id>",
+		Short: "Show cluster ingress controller load balancers",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleClusterIngress(cmd, api); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	command.AddJSONOutput(cmd)
+	return cmd
+}
+
+func handleClusterIngress(cmd *cobra.Command, api client.Interface) error {
+	cluster, err := getClusterFromArgs(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	ingress, err := api.GetClusterIngressController(cmd.Context(), sdk.ClusterId(cluster.Id))
+	if err != nil {
+		return err
+	}
+
+	if command.OutputJSON() {
+		command.PrintOutput(ingress)
+		return nil
+	}
+
+	printClusterIngressTable(cmd.OutOrStdout(), ingress)
+	return nil
+}
+
+func printClusterIngressTable(out io.Writer, ingress *sdk.KubernetesIngressController) {
+	ports := make([]string, len(ingress.Ports))
+	for i, port := range ingress.Ports {
+		ports[i] = strconv.Itoa(port)
+	}
+
+	// Group load balancers by cloud.
+	lbs := append([]sdk.IngressLoadBalancer{}, ingress.LoadBalancers...)
+	sort.SliceStable(lbs, func(i, j int) bool {
+		return lbs[i].Type < lbs[j].Type
+	})
+
+	t := table.NewWriter()
+	t.SetStyle(command.DefaultTableStyle)
+	t.SetOutputMirror(out)
+	t.AppendHeader(table.Row{"Type", "Address", "Ports"})
+	for _, lb := range lbs {
+		t.AppendRow(table.Row{
+			lb.Type,
+			lb.Address,
+			strings.Join(ports, ","),
+		})
+	}
+	t.Render()
+}
+
+// ingressLoadBalancerAddresses returns addresses of all cluster ingress load balancers.
+func ingressLoadBalancerAddresses(ingress *sdk.KubernetesIngressController) []string {
+	res := make([]string, len(ingress.LoadBalancers))
+	for i, lb := range ingress.LoadBalancers {
+		res[i] = lb.Address
+	}
+	return res
+}
@@ -38,6 +38,9 @@ func newClusterListCmd(log logrus.FieldLogger, api client.Interface) *cobra.Comm
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List all clusters",
+		Long: `
+Deleted clusters are hidden unless --include-deleted is passed.
+`,
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := handleListClusters(cmd, api); err != nil {
 				log.Fatal(err)
@@ -54,6 +57,9 @@ func handleListClusters(cmd *cobra.Command, api client.Interface) error {
 	if err != nil {
 		return err
 	}
+	if !flagIncludeDeletedClusters {
+		resp = filterDeletedClusters(resp)
+	}
 
 	if command.OutputJSON() {
 		command.PrintOutput(resp)
@@ -64,6 +70,16 @@ func handleListClusters(cmd *cobra.Command, api client.Interface) error {
 	return nil
 }
 
+func filterDeletedClusters(items []sdk.KubernetesCluster) []sdk.KubernetesCluster {
+	res := make([]sdk.KubernetesCluster, 0, len(items))
+	for _, item := range items {
+		if item.Status != "deleted" {
+			res = append(res, item)
+		}
+	}
+	return res
+}
+
 func printClustersListTable(out io.Writer, items []sdk.KubernetesCluster) {
 	t := table.NewWriter()
 	t.SetStyle(command.DefaultTableStyle)
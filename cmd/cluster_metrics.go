@@ -0,0 +1,303 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+)
+
+var supportedMetricsTypes = []string{
+	string(sdk.MetricsType_node_cpu_usage),
+	string(sdk.MetricsType_node_cpu_requests),
+	string(sdk.MetricsType_node_memory_usage),
+	string(sdk.MetricsType_node_memory_requests),
+	string(sdk.MetricsType_cloud_cpu_usage),
+	string(sdk.MetricsType_cloud_cpu_requests),
+	string(sdk.MetricsType_cloud_memory_usage),
+	string(sdk.MetricsType_cloud_memory_requests),
+	string(sdk.MetricsType_cloud_pods),
+}
+
+// metricSeriesNameLabels are labels used to name series in order of preference.
+var metricSeriesNameLabels = []string{"node", "node_name", "instance", "cloud", "provider"}
+
+const sparklineWidth = 30
+
+var sparklineTicks = []rune("▁▂▃▄▅▆▇█")
+
+type clusterMetricsOptions struct {
+	Type     string
+	Watch    bool
+	Interval time.Duration
+}
+
+type metricSeries struct {
+	Name   string            `json:"name"`
+	Labels map[string]string `json:"labels"`
+	Values []float64         `json:"values"`
+}
+
+func newClusterMetricsCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := clusterMetricsOptions{}
+	cmd := &cobra.Command{
+		Use:   "metrics <cluster_name_or_id>",
+		Short: "Show cluster metrics",
+		Long: `
+Examples:
+  # Show nodes cpu usage.
+  cast cluster metrics my-cluster --type=node_cpu_usage
+
+  # Refresh clouds memory usage every 10 seconds.
+  cast cluster metrics my-cluster --type=cloud_memory_usage --watch --interval=10s
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleClusterMetrics(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVar(&opts.Type, "type", string(sdk.MetricsType_node_cpu_usage), fmt.Sprintf("metrics type, possible values: %s", strings.Join(supportedMetricsTypes, ",")))
+	cmd.PersistentFlags().BoolVarP(&opts.Watch, "watch", "w", false, "refresh metrics periodically")
+	cmd.PersistentFlags().DurationVar(&opts.Interval, "interval", 30*time.Second, "metrics refresh interval in watch mode")
+	command.AddJSONOutput(cmd)
+	return cmd
+}
+
+func handleClusterMetrics(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts clusterMetricsOptions) error {
+	if !isSupportedMetricsType(opts.Type) {
+		return fmt.Errorf("unsupported metrics type %q, possible values: %s", opts.Type, strings.Join(supportedMetricsTypes, ","))
+	}
+	if opts.Watch && opts.Interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	if opts.Watch && command.OutputJSON() {
+		return errors.New("--watch can't be used with json output")
+	}
+
+	cluster, err := getClusterFromArgs(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	out := cmd.OutOrStdout()
+	if !opts.Watch {
+		series, err := getMetricSeries(cmd, log, api, cluster.Id, opts.Type)
+		if err != nil {
+			return err
+		}
+		if command.OutputJSON() {
+			command.PrintOutput(series)
+			return nil
+		}
+		printMetricsTable(out, series)
+		return nil
+	}
+
+	history := map[string][]float64{}
+	for {
+		series, err := getMetricSeries(cmd, log, api, cluster.Id, opts.Type)
+		if err != nil {
+			return err
+		}
+		series = appendMetricsHistory(history, series)
+
+		// Clear terminal screen before each refresh.
+		fmt.Fprint(out, "\033[H\033[2J")
+		fmt.Fprintf(out, "Cluster %s %s, refreshed at %s\n\n", cluster.Name, opts.Type, time.Now().Format(time.RFC3339))
+		printMetricsTable(out, series)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(opts.Interval):
+		}
+	}
+}
+
+func getMetricSeries(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, clusterID, metricsType string) ([]metricSeries, error) {
+	metrics, err := api.GetClusterMetrics(cmd.Context(), sdk.ClusterId(clusterID), sdk.MetricsType(metricsType))
+	if err != nil {
+		return nil, err
+	}
+	if metrics.Status != nil && *metrics.Status == "error" {
+		return nil, fmt.Errorf("metrics query failed: %s", nodeValueString(metrics.Error))
+	}
+	if metrics.Warnings != nil {
+		for _, w := range *metrics.Warnings {
+			log.Warn(w)
+		}
+	}
+	if metrics.Data == nil || metrics.Data.Result == nil {
+		return []metricSeries{}, nil
+	}
+	return parseMetricsResult(*metrics.Data.Result)
+}
+
+func isSupportedMetricsType(v string) bool {
+	return indexOfString(supportedMetricsTypes, v) >= 0
+}
+
+// parseMetricsResult parses prometheus vector or matrix result into series sorted by name.
+func parseMetricsResult(result []map[string]interface{}) ([]metricSeries, error) {
+	series := make([]metricSeries, 0, len(result))
+	for _, item := range result {
+		s := metricSeries{Labels: map[string]string{}}
+		if metric, ok := item["metric"].(map[string]interface{}); ok {
+			for k, v := range metric {
+				s.Labels[k] = fmt.Sprint(v)
+			}
+		}
+		s.Name = metricSeriesName(s.Labels)
+
+		var samples []interface{}
+		if values, ok := item["values"].([]interface{}); ok {
+			samples = values
+		} else if value, ok := item["value"]; ok {
+			samples = []interface{}{value}
+		}
+		for _, sample := range samples {
+			v, err := parseMetricSample(sample)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %s metrics: %w", s.Name, err)
+			}
+			// Prometheus returns NaN and Inf values which can't be drawn or encoded to JSON.
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				continue
+			}
+			s.Values = append(s.Values, v)
+		}
+		series = append(series, s)
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].Name < series[j].Name
+	})
+	return series, nil
+}
+
+// parseMetricSample parses prometheus [<unix_time>, "<value>"] sample value.
+func parseMetricSample(sample interface{}) (float64, error) {
+	pair, ok := sample.([]interface{})
+	if !ok || len(pair) != 2 {
+		return 0, fmt.Errorf("invalid sample %v", sample)
+	}
+	switch v := pair[1].(type) {
+	case string:
+		return strconv.ParseFloat(v, 64)
+	case float64:
+		return v, nil
+	default:
+		return 0, fmt.Errorf("invalid sample value %v", pair[1])
+	}
+}
+
+func metricSeriesName(labels map[string]string) string {
+	for _, l := range metricSeriesNameLabels {
+		if v, ok := labels[l]; ok {
+			return v
+		}
+	}
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// appendMetricsHistory keeps series values between watch refreshes. Vector results have single value
+// or none if it was skipped so history is accumulated, matrix results already contain history and replace it.
+func appendMetricsHistory(history map[string][]float64, series []metricSeries) []metricSeries {
+	for i, s := range series {
+		values := s.Values
+		if len(values) <= 1 {
+			values = append(history[s.Name], values...)
+		}
+		if len(values) > sparklineWidth {
+			values = values[len(values)-sparklineWidth:]
+		}
+		history[s.Name] = values
+		series[i].Values = values
+	}
+	return series
+}
+
+func printMetricsTable(out io.Writer, series []metricSeries) {
+	t := table.NewWriter()
+	t.SetStyle(command.DefaultTableStyle)
+	t.SetOutputMirror(out)
+	t.AppendHeader(table.Row{"Name", "Current", "Min", "Max", "Trend"})
+	for _, s := range series {
+		if len(s.Values) == 0 {
+			t.AppendRow(table.Row{s.Name, "", "", "", ""})
+			continue
+		}
+		min, max := math.Inf(1), math.Inf(-1)
+		for _, v := range s.Values {
+			min = math.Min(min, v)
+			max = math.Max(max, v)
+		}
+		t.AppendRow(table.Row{
+			s.Name,
+			formatMetricValue(s.Values[len(s.Values)-1]),
+			formatMetricValue(min),
+			formatMetricValue(max),
+			sparkline(s.Values),
+		})
+	}
+	t.Render()
+}
+
+func formatMetricValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+// sparkline draws last values as unicode bars scaled between min and max value.
+func sparkline(values []float64) string {
+	if len(values) > sparklineWidth {
+		values = values[len(values)-sparklineWidth:]
+	}
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		min = math.Min(min, v)
+		max = math.Max(max, v)
+	}
+	var b strings.Builder
+	for _, v := range values {
+		idx := 0
+		if max > min {
+			idx = int((v - min) / (max - min) * float64(len(sparklineTicks)-1))
+		}
+		b.WriteRune(sparklineTicks[idx])
+	}
+	return b.String()
+}
@@ -0,0 +1,64 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+func newClusterPauseCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	var notes string
+	cmd := &cobra.Command{
+		Use:   "pause <cluster_name_or_id>",
+		Short: "Pause cluster",
+		Long: `
+Paused cluster can be brought back with 'cast cluster resume'.
+
+Examples:
+  cast cluster pause my-demo-cluster --notes="paused for the weekend"
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleClusterPause(cmd, log, api, notes); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVar(&notes, "notes", "", "optional notes explaining why cluster is paused")
+	return cmd
+}
+
+func handleClusterPause(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, notes string) error {
+	cluster, err := getClusterFromArgs(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	req := sdk.PauseClusterJSONRequestBody{}
+	if notes != "" {
+		req.Notes = &notes
+	}
+	if _, err := api.PauseCluster(cmd.Context(), sdk.ClusterId(cluster.Id), req); err != nil {
+		return err
+	}
+
+	log.Infof("Cluster %s pause is now in progress. Check status by running 'cast cluster get %s'", cluster.Name, cluster.Name)
+	return nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+)
+
+// confirmClusterPrice prints cluster price estimate and asks user to confirm cluster creation.
+func confirmClusterPrice(cmd *cobra.Command, api client.Interface, req *sdk.CreateNewClusterJSONRequestBody, confirm bool) (bool, error) {
+	estimate, err := estimateClusterPrice(cmd.Context(), api, req)
+	if err != nil {
+		return false, err
+	}
+	printClusterCostEstimate(cmd.OutOrStdout(), estimate)
+
+	if confirm {
+		return true, nil
+	}
+	if err := survey.AskOne(&survey.Confirm{
+		Message: "Create cluster?",
+		Default: true,
+	}, &confirm); err != nil {
+		return false, err
+	}
+	return confirm, nil
+}
+
+// estimateClusterPrice plans cluster price from the same nodes, network, region and clouds as cluster creation request.
+func estimateClusterPrice(ctx context.Context, api client.Interface, req *sdk.CreateNewClusterJSONRequestBody) (*sdk.ClusterCostEstimate, error) {
+	credentials, err := api.ListCloudCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var clouds []sdk.CloudType
+	for _, id := range req.CloudCredentialsIDs {
+		for _, cred := range credentials {
+			if cred.Id == id {
+				clouds = append(clouds, sdk.CloudType(cred.Cloud))
+				break
+			}
+		}
+	}
+
+	nodes := req.Nodes
+	return api.PlanClusterPrice(ctx, sdk.PlanClusterPriceJSONRequestBody{
+		Addons:  req.Addons,
+		Clouds:  &clouds,
+		Network: req.Network,
+		Nodes:   &nodes,
+		Region:  &sdk.ClusterRegion{Name: req.Region},
+	})
+}
+
+func printClusterCostEstimate(out io.Writer, estimate *sdk.ClusterCostEstimate) {
+	if estimate.PerCloud != nil && estimate.PerCloud.Details != nil {
+		t := table.NewWriter()
+		t.SetStyle(command.DefaultTableStyle)
+		t.SetOutputMirror(out)
+		t.AppendHeader(table.Row{"Cloud", "Units", "Hourly", "Monthly"})
+		for _, item := range *estimate.PerCloud.Details {
+			t.AppendRow(table.Row{
+				item.Name,
+				item.UnitCount,
+				formatPrice(item.FullPrice.Hourly, item.FullPrice.CurrencyCode),
+				formatPrice(item.FullPrice.Monthly, item.FullPrice.CurrencyCode),
+			})
+		}
+		t.Render()
+		fmt.Fprintln(out)
+	}
+
+	if estimate.PerType != nil && len(estimate.PerType.AdditionalProperties) > 0 {
+		types := make([]string, 0, len(estimate.PerType.AdditionalProperties))
+		for name := range estimate.PerType.AdditionalProperties {
+			types = append(types, name)
+		}
+		sort.Strings(types)
+
+		t := table.NewWriter()
+		t.SetStyle(command.DefaultTableStyle)
+		t.SetOutputMirror(out)
+		t.AppendHeader(table.Row{"Component", "Units", "Hourly", "Monthly"})
+		for _, name := range types {
+			item, _ := estimate.PerType.Get(name)
+			var units int
+			if item.UnitCount != nil {
+				units = *item.UnitCount
+			}
+			price := item.Price
+			if price == nil {
+				price = &sdk.EstimatedPriceAmount{}
+			}
+			t.AppendRow(table.Row{
+				name,
+				units,
+				formatPrice(price.Hourly, price.CurrencyCode),
+				formatPrice(price.Monthly, price.CurrencyCode),
+			})
+		}
+		t.Render()
+		fmt.Fprintln(out)
+	}
+
+	if estimate.Total != nil {
+		fmt.Fprintf(out, "Estimated total: %s per hour, %s per month\n",
+			formatPrice(estimate.Total.Hourly, estimate.Total.CurrencyCode),
+			formatPrice(estimate.Total.Monthly, estimate.Total.CurrencyCode),
+		)
+	}
+}
+
+func formatPrice(amount, currency *string) string {
+	if amount == nil {
+		return "-"
+	}
+	if currency == nil || *currency == "" {
+		return fmt.Sprintf("%s USD", *amount)
+	}
+	return fmt.Sprintf("%s %s", *amount, *currency)
+}
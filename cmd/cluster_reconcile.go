@@ -17,6 +17,8 @@ limitations under the License.
 package cmd
 
 import (
+	"fmt"
+
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 
@@ -24,6 +26,8 @@ import (
 	"github.com/castai/cli/pkg/client/sdk"
 )
 
+var flagClusterReconcileWait bool
+
 func newClusterReconcileCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "reconcile <cluster_name_or_id>",
@@ -34,6 +38,7 @@ func newClusterReconcileCmd(log logrus.FieldLogger, api client.Interface) *cobra
 			}
 		},
 	}
+	cmd.PersistentFlags().BoolVar(&flagClusterReconcileWait, "wait", false, "wait until cluster is reconciled, eg. --wait=true")
 	return cmd
 }
 
@@ -43,10 +48,37 @@ func handleClusterReconcile(cmd *cobra.Command, log logrus.FieldLogger, api clie
 		return err
 	}
 
-	if err := api.TriggerClusterReconcile(cmd.Context(), sdk.ClusterId(cluster.Id)); err != nil {
+	ctx := cmd.Context()
+	events := newClusterEventsPrinter(log, api, cluster.Id)
+	if flagClusterReconcileWait {
+		events.skipExisting(ctx)
+	}
+	if err := api.TriggerClusterReconcile(ctx, sdk.ClusterId(cluster.Id)); err != nil {
 		return err
 	}
 
-	log.Info("Cluster reconcile triggered successfully")
+	if !flagClusterReconcileWait {
+		log.Info("Cluster reconcile triggered successfully")
+		return nil
+	}
+
+	log.Info("Cluster reconcile triggered successfully, waiting until it's finished")
+	// Reconcile is finished when cluster reconcile time moves past the one before trigger.
+	reconciledAt := cluster.ReconciledAt
+	var status string
+	err = waitClusterWithProgress(ctx, log, api, events, "reconcile", clusterChangeWaitTimeout, func(c *sdk.KubernetesCluster) (bool, error) {
+		if c == nil {
+			return false, fmt.Errorf("cluster %s not found", cluster.Name)
+		}
+		if c.ReconciledAt == nil || (reconciledAt != nil && !c.ReconciledAt.After(*reconciledAt)) {
+			return false, nil
+		}
+		status = c.Status
+		return true, clusterStatusError(c)
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof("Cluster reconcile finished, status=%s", status)
 	return nil
 }
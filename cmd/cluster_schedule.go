@@ -0,0 +1,167 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+)
+
+// pauseScheduleDays lists week days in the order and format used by pause schedule spans.
+var pauseScheduleDays = []time.Weekday{
+	time.Monday,
+	time.Tuesday,
+	time.Wednesday,
+	time.Thursday,
+	time.Friday,
+	time.Saturday,
+	time.Sunday,
+}
+
+func newClusterScheduleCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "schedule",
+		Short: "Manage cluster pause schedule",
+	}
+}
+
+// parsePauseScheduleSpan parses span in format days,HH:MM-HH:MM where days is a comma separated list
+// of week days or day ranges, eg. mon-fri,08:00-19:00 or sat,sun,10:00-14:00. Span is expanded to one
+// PauseScheduleSpan per day.
+func parsePauseScheduleSpan(s string) ([]sdk.PauseScheduleSpan, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("invalid span %q, expected format days,HH:MM-HH:MM, eg. mon-fri,08:00-19:00", s)
+	}
+
+	hours := strings.Split(parts[len(parts)-1], "-")
+	if len(hours) != 2 {
+		return nil, fmt.Errorf("invalid span %q time range, expected format HH:MM-HH:MM", s)
+	}
+
+	var days []time.Weekday
+	for _, p := range parts[:len(parts)-1] {
+		d, err := parseWeekdayRange(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid span %q: %w", s, err)
+		}
+		days = append(days, d...)
+	}
+
+	res := make([]sdk.PauseScheduleSpan, len(days))
+	for i, day := range days {
+		res[i] = sdk.PauseScheduleSpan{
+			DayOfTheWeek: day.String(),
+			ActiveFrom:   strings.TrimSpace(hours[0]),
+			ActiveTo:     strings.TrimSpace(hours[1]),
+		}
+		if err := validatePauseScheduleSpan(res[i]); err != nil {
+			return nil, fmt.Errorf("invalid span %q: %w", s, err)
+		}
+	}
+	return res, nil
+}
+
+// parseWeekdayRange parses single day (eg. mon) or days range (eg. mon-fri).
+func parseWeekdayRange(s string) ([]time.Weekday, error) {
+	p := strings.Split(strings.TrimSpace(s), "-")
+	if len(p) > 2 {
+		return nil, fmt.Errorf("invalid days range %q", s)
+	}
+	from, err := parseWeekdayIndex(p[0])
+	if err != nil {
+		return nil, err
+	}
+	to := from
+	if len(p) == 2 {
+		to, err = parseWeekdayIndex(p[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+	if to < from {
+		return nil, fmt.Errorf("invalid days range %q, first day should come before last day", s)
+	}
+	return pauseScheduleDays[from : to+1], nil
+}
+
+func parseWeekdayIndex(s string) (int, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	for i, day := range pauseScheduleDays {
+		name := strings.ToLower(day.String())
+		if len(s) >= 3 && strings.HasPrefix(name, s) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown week day %q, eg. mon, tue, wed, thu, fri, sat, sun", s)
+}
+
+// validatePauseScheduleSpan checks that span day is a valid week day and active hours are in HH:MM format.
+func validatePauseScheduleSpan(span sdk.PauseScheduleSpan) error {
+	var validDay bool
+	for _, day := range pauseScheduleDays {
+		if span.DayOfTheWeek == day.String() {
+			validDay = true
+			break
+		}
+	}
+	if !validDay {
+		return fmt.Errorf("unknown week day %q", span.DayOfTheWeek)
+	}
+
+	from, err := time.Parse("15:04", span.ActiveFrom)
+	if err != nil {
+		return fmt.Errorf("invalid active from time %q, expected format HH:MM", span.ActiveFrom)
+	}
+	to, err := time.Parse("15:04", span.ActiveTo)
+	if err != nil {
+		return fmt.Errorf("invalid active to time %q, expected format HH:MM", span.ActiveTo)
+	}
+	if !from.Before(to) {
+		return fmt.Errorf("active from time %s should be before active to time %s", span.ActiveFrom, span.ActiveTo)
+	}
+	return nil
+}
+
+func printPauseSchedule(out io.Writer, schedule *sdk.PauseSchedule) {
+	status := "disabled"
+	if schedule.Enabled {
+		status = "enabled"
+	}
+	if schedule.Name != "" {
+		fmt.Fprintf(out, "Name: %s\n", schedule.Name)
+	}
+	fmt.Fprintf(out, "Status: %s\n", status)
+	fmt.Fprintf(out, "Time zone: %s\n", schedule.TimeZone)
+
+	t := table.NewWriter()
+	t.SetStyle(command.DefaultTableStyle)
+	t.SetOutputMirror(out)
+	t.AppendHeader(table.Row{"Day", "Active_From", "Active_To"})
+	for _, span := range schedule.Spans {
+		t.AppendRow(table.Row{span.DayOfTheWeek, span.ActiveFrom, span.ActiveTo})
+	}
+	t.Render()
+}
@@ -0,0 +1,123 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+type clusterScheduleSetOptions struct {
+	Name     string
+	TimeZone string
+	Spans    []string
+	Disabled bool
+}
+
+func newClusterScheduleSetCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := clusterScheduleSetOptions{}
+	cmd := &cobra.Command{
+		Use:   "set <cluster_name_or_id>",
+		Short: "Set cluster pause schedule",
+		Long: `
+Cluster is active during given spans and paused the rest of the time. Span format is days,HH:MM-HH:MM
+where days is a comma separated list of week days or day ranges.
+
+Examples:
+  # Keep cluster running only during working hours.
+  cast cluster schedule set my-demo-cluster --tz=Europe/Vilnius --span=mon-fri,08:00-19:00
+
+  # Add different hours for the weekend.
+  cast cluster schedule set my-demo-cluster --tz=Europe/Vilnius --span=mon-fri,08:00-19:00 --span=sat,sun,10:00-14:00
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleClusterScheduleSet(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVar(&opts.Name, "name", "", "optional schedule name")
+	cmd.PersistentFlags().StringVar(&opts.TimeZone, "tz", "", "IANA time zone of the schedule spans, eg. --tz=Europe/Vilnius")
+	cmd.PersistentFlags().StringArrayVar(&opts.Spans, "span", []string{}, "active cluster hours, eg. --span=mon-fri,08:00-19:00")
+	cmd.PersistentFlags().BoolVar(&opts.Disabled, "disabled", false, "save schedule without enabling it")
+	cmd.MarkPersistentFlagRequired("tz")
+	cmd.MarkPersistentFlagRequired("span")
+	return cmd
+}
+
+func handleClusterScheduleSet(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts clusterScheduleSetOptions) error {
+	req, err := toPauseSchedule(opts)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := getClusterFromArgs(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	schedule, err := api.SetClusterPauseSchedule(cmd.Context(), sdk.ClusterId(cluster.Id), req)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Cluster %s pause schedule set", cluster.Name)
+	printPauseSchedule(cmd.OutOrStdout(), schedule)
+	return nil
+}
+
+func toPauseSchedule(opts clusterScheduleSetOptions) (sdk.SetClusterPauseScheduleJSONRequestBody, error) {
+	if _, err := time.LoadLocation(opts.TimeZone); err != nil {
+		return sdk.SetClusterPauseScheduleJSONRequestBody{}, fmt.Errorf("invalid time zone %q: %w", opts.TimeZone, err)
+	}
+
+	req := sdk.SetClusterPauseScheduleJSONRequestBody{
+		Enabled:  !opts.Disabled,
+		Name:     opts.Name,
+		TimeZone: opts.TimeZone,
+	}
+	type daySpan struct {
+		from, to time.Time
+		value    string
+	}
+	seen := map[string][]daySpan{}
+	for _, s := range opts.Spans {
+		spans, err := parsePauseScheduleSpan(s)
+		if err != nil {
+			return sdk.SetClusterPauseScheduleJSONRequestBody{}, err
+		}
+		for _, span := range spans {
+			// Times are already validated by parsePauseScheduleSpan.
+			from, _ := time.Parse("15:04", span.ActiveFrom)
+			to, _ := time.Parse("15:04", span.ActiveTo)
+			for _, prev := range seen[span.DayOfTheWeek] {
+				if from.Before(prev.to) && prev.from.Before(to) {
+					return sdk.SetClusterPauseScheduleJSONRequestBody{}, fmt.Errorf("spans %q and %q overlap on %s", prev.value, s, span.DayOfTheWeek)
+				}
+			}
+			seen[span.DayOfTheWeek] = append(seen[span.DayOfTheWeek], daySpan{from: from, to: to, value: s})
+			req.Spans = append(req.Spans, span)
+		}
+	}
+	return req, nil
+}
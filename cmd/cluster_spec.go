@@ -0,0 +1,410 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+const (
+	clusterSpecAPIVersion = "v1"
+	clusterSpecKind       = "Cluster"
+)
+
+// clusterSpec is a versioned cluster manifest which mirrors cluster create flags.
+type clusterSpec struct {
+	APIVersion    string             `yaml:"apiVersion" json:"apiVersion"`
+	Kind          string             `yaml:"kind" json:"kind"`
+	Name          string             `yaml:"name" json:"name"`
+	Region        string             `yaml:"region" json:"region"`
+	Credentials   []string           `yaml:"credentials" json:"credentials"`
+	Configuration string             `yaml:"configuration,omitempty" json:"configuration,omitempty"`
+	Nodes         []string           `yaml:"nodes,omitempty" json:"nodes,omitempty"`
+	Network       clusterSpecNetwork `yaml:"network,omitempty" json:"network,omitempty"`
+	Addons        clusterSpecAddons  `yaml:"addons,omitempty" json:"addons,omitempty"`
+}
+
+type clusterSpecNetwork struct {
+	VPN                string `yaml:"vpn,omitempty" json:"vpn,omitempty"`
+	PrivateWorkerNodes bool   `yaml:"privateWorkerNodes,omitempty" json:"privateWorkerNodes,omitempty"`
+	AWSVPCCidr         string `yaml:"awsVpcCidr,omitempty" json:"awsVpcCidr,omitempty"`
+	GCPVPCCidr         string `yaml:"gcpVpcCidr,omitempty" json:"gcpVpcCidr,omitempty"`
+	AzureVPCCidr       string `yaml:"azureVpcCidr,omitempty" json:"azureVpcCidr,omitempty"`
+	DOVPCCidr          string `yaml:"doVpcCidr,omitempty" json:"doVpcCidr,omitempty"`
+}
+
+// clusterSpecAddons is applied only when cluster is created.
+type clusterSpecAddons struct {
+	Keda bool `yaml:"keda,omitempty" json:"keda,omitempty"`
+}
+
+// readClusterSpec reads cluster spec from YAML or JSON file. Pass - to read from stdin.
+func readClusterSpec(path string) (*clusterSpec, error) {
+	var r io.Reader
+	if path == "-" {
+		r = os.Stdin
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading cluster spec: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("reading cluster spec: %w", err)
+	}
+	return parseClusterSpec(data)
+}
+
+func parseClusterSpec(data []byte) (*clusterSpec, error) {
+	var spec clusterSpec
+	// JSON is valid YAML so both formats are parsed the same way.
+	if err := yaml.UnmarshalStrict(data, &spec); err != nil {
+		return nil, fmt.Errorf("parsing cluster spec: %w", err)
+	}
+	if spec.APIVersion != clusterSpecAPIVersion {
+		return nil, fmt.Errorf("unsupported cluster spec apiVersion %q, expected %q", spec.APIVersion, clusterSpecAPIVersion)
+	}
+	if spec.Kind != clusterSpecKind {
+		return nil, fmt.Errorf("unsupported cluster spec kind %q, expected %q", spec.Kind, clusterSpecKind)
+	}
+	if spec.Name == "" {
+		return nil, errors.New("cluster spec name is required")
+	}
+	return &spec, nil
+}
+
+func (s *clusterSpec) toCreateOptions() clusterCreateOptions {
+	return clusterCreateOptions{
+		Name:               s.Name,
+		Region:             s.Region,
+		Credentials:        s.Credentials,
+		Configuration:      s.Configuration,
+		Nodes:              s.Nodes,
+		VPN:                s.Network.VPN,
+		PrivateWorkerNodes: s.Network.PrivateWorkerNodes,
+		AWSVPCCidr:         s.Network.AWSVPCCidr,
+		GCPVPCCidr:         s.Network.GCPVPCCidr,
+		AzureVPCCidr:       s.Network.AzureVPCCidr,
+		DOVPCCidr:          s.Network.DOVPCCidr,
+	}
+}
+
+// clusterPlan describes changes needed to converge live cluster to the desired spec.
+type clusterPlan struct {
+	Name        string
+	Create      *sdk.CreateNewClusterJSONRequestBody
+	Update      *sdk.UpdateClusterJSONRequestBody
+	Changes     []clusterFieldChange
+	AddNodes    []sdk.Node
+	DeleteNodes []sdk.Node
+	// KeepNodes are live nodes missing from the spec which are not deleted because pruning is disabled.
+	KeepNodes []sdk.Node
+}
+
+type clusterFieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+func (p *clusterPlan) empty() bool {
+	return p.Create == nil && p.Update == nil && len(p.AddNodes) == 0 && len(p.DeleteNodes) == 0
+}
+
+func (p *clusterPlan) summary() string {
+	action := "update"
+	if p.Create != nil {
+		action = "create"
+	}
+	return fmt.Sprintf("Plan: cluster %s, %d nodes to add, %d nodes to delete, %d fields to change", action, len(p.AddNodes), len(p.DeleteNodes), len(p.Changes))
+}
+
+// buildClusterPlan compares spec with live cluster and its nodes. Nil cluster means that cluster should be created.
+// Live nodes missing from the spec are deleted only when prune is set.
+func buildClusterPlan(lists *clusterCreationSelectLists, spec *clusterSpec, cluster *sdk.KubernetesCluster, nodes []sdk.Node, prune bool) (*clusterPlan, error) {
+	req, err := toCreateClusterRequest(lists, spec.toCreateOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &clusterPlan{Name: spec.Name}
+	if cluster == nil {
+		if spec.Addons.Keda {
+			req.Addons.Keda = &sdk.KedaConfig{Enabled: true}
+		}
+		plan.Create = req
+		plan.AddNodes = req.Nodes
+		return plan, nil
+	}
+
+	if cluster.Region.Name != req.Region {
+		return nil, fmt.Errorf("cluster region can't be changed from %s to %s", cluster.Region.Name, req.Region)
+	}
+
+	if !sameStringSet(cluster.CloudCredentialsIDs, req.CloudCredentialsIDs) {
+		plan.Changes = append(plan.Changes, clusterFieldChange{
+			Field: "credentials",
+			From:  strings.Join(credentialsNames(lists, cluster.CloudCredentialsIDs), ", "),
+			To:    strings.Join(credentialsNames(lists, req.CloudCredentialsIDs), ", "),
+		})
+	}
+
+	network := mergeNetwork(cluster.Network, req.Network)
+	plan.Changes = append(plan.Changes, diffNetwork(cluster.Network, network)...)
+
+	if len(plan.Changes) > 0 {
+		plan.Update = &sdk.UpdateClusterJSONRequestBody{
+			CloudCredentialsIDs: req.CloudCredentialsIDs,
+			Network:             network,
+		}
+	}
+
+	add, del := diffNodes(req.Nodes, nodes)
+	plan.AddNodes = add
+	if !prune {
+		plan.KeepNodes = del
+		return plan, nil
+	}
+	if masters := activeMasterNodes(nodes); len(masters) > 0 && len(masters) == len(activeMasterNodes(del)) {
+		return nil, fmt.Errorf("cluster %s can't be left without master nodes, keep at least one existing master node in the spec", cluster.Name)
+	}
+	plan.DeleteNodes = del
+	return plan, nil
+}
+
+func activeMasterNodes(nodes []sdk.Node) []sdk.Node {
+	var res []sdk.Node
+	for _, node := range nodes {
+		if phase := nodePhase(node); phase == "deleting" || phase == "deleted" {
+			continue
+		}
+		if node.Role == sdk.NodeType_master {
+			res = append(res, node)
+		}
+	}
+	return res
+}
+
+// mergeNetwork applies network fields set in the spec on top of the live network.
+func mergeNetwork(live, desired *sdk.Network) *sdk.Network {
+	res := sdk.Network{}
+	if live != nil {
+		res = *live
+	}
+	if desired.Vpn != nil {
+		res.Vpn = desired.Vpn
+	}
+	res.PrivateWorkerNodes = desired.PrivateWorkerNodes
+	if desired.Aws != nil {
+		res.Aws = desired.Aws
+	}
+	if desired.Gcp != nil {
+		res.Gcp = desired.Gcp
+	}
+	if desired.Azure != nil {
+		res.Azure = desired.Azure
+	}
+	if desired.Do != nil {
+		res.Do = desired.Do
+	}
+	return &res
+}
+
+func diffNetwork(from, to *sdk.Network) []clusterFieldChange {
+	if from == nil {
+		from = &sdk.Network{}
+	}
+	var res []clusterFieldChange
+	add := func(field, from, to string) {
+		if from != to {
+			res = append(res, clusterFieldChange{Field: field, From: from, To: to})
+		}
+	}
+	add("vpn", vpnTypeName(from.Vpn), vpnTypeName(to.Vpn))
+	add("privateWorkerNodes", fmt.Sprint(from.PrivateWorkerNodes), fmt.Sprint(to.PrivateWorkerNodes))
+	add("awsVpcCidr", vpcCidr(from.Aws), vpcCidr(to.Aws))
+	add("gcpVpcCidr", vpcCidr(from.Gcp), vpcCidr(to.Gcp))
+	add("azureVpcCidr", vpcCidr(from.Azure), vpcCidr(to.Azure))
+	add("doVpcCidr", vpcCidr(from.Do), vpcCidr(to.Do))
+	return res
+}
+
+// diffNodes matches desired nodes with live nodes and returns nodes which should be added and deleted.
+func diffNodes(desired, live []sdk.Node) (add, del []sdk.Node) {
+	var active []sdk.Node
+	for _, node := range live {
+		if phase := nodePhase(node); phase == "deleting" || phase == "deleted" {
+			continue
+		}
+		active = append(active, node)
+	}
+	sort.SliceStable(active, func(i, j int) bool {
+		return nodeName(active[i]) < nodeName(active[j])
+	})
+
+	matched := make([]bool, len(active))
+	for _, d := range desired {
+		found := false
+		for i, l := range active {
+			if !matched[i] && nodeMatchesSpec(l, d) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			add = append(add, d)
+		}
+	}
+	for i, l := range active {
+		if !matched[i] {
+			del = append(del, l)
+		}
+	}
+	return add, del
+}
+
+func nodeMatchesSpec(node, spec sdk.Node) bool {
+	if node.Cloud != spec.Cloud || node.Role != spec.Role || isSpotNode(node) != isSpotNode(spec) {
+		return false
+	}
+	if spec.InstanceType != "" {
+		return node.InstanceType == spec.InstanceType
+	}
+	return node.Shape == spec.Shape
+}
+
+// printClusterPlan prints planned changes in a human readable form.
+func printClusterPlan(out io.Writer, plan *clusterPlan) {
+	switch {
+	case plan.empty():
+		fmt.Fprintf(out, "Cluster %s is up to date\n", plan.Name)
+	case plan.Create != nil:
+		fmt.Fprintf(out, "Cluster %s will be created:\n", plan.Name)
+		fmt.Fprintf(out, "  + region: %s\n", plan.Create.Region)
+	default:
+		fmt.Fprintf(out, "Cluster %s will be updated:\n", plan.Name)
+	}
+	for _, change := range plan.Changes {
+		fmt.Fprintf(out, "  ~ %s: %s -> %s\n", change.Field, change.From, change.To)
+	}
+	for _, node := range plan.AddNodes {
+		fmt.Fprintf(out, "  + node %s\n", nodeSpecString(node))
+	}
+	for _, node := range plan.DeleteNodes {
+		fmt.Fprintf(out, "  - node %s (%s)\n", nodeName(node), nodeSpecString(node))
+	}
+	for _, node := range plan.KeepNodes {
+		fmt.Fprintf(out, "  ! node %s (%s) is not in the spec, use --prune to delete it\n", nodeName(node), nodeSpecString(node))
+	}
+}
+
+// nodeSpecString formats node in the cloud-role-shape[@instance_type][:spot[=max_price]] format which is accepted
+// by --node flag. Shape is omitted if it's not one of supported shapes, eg. when node was created by instance type.
+func nodeSpecString(node sdk.Node) string {
+	res := fmt.Sprintf("%s-%s", node.Cloud, node.Role)
+	shape, instanceType := string(node.Shape), node.InstanceType
+	if indexOfString(supportedNodeShapes, shape) >= 0 {
+		res += "-" + shape
+	} else if instanceType == "" {
+		instanceType = shape
+	}
+	if instanceType != "" {
+		res += "@" + instanceType
+	}
+	if isSpotNode(node) {
+		res += ":spot"
+		if node.SpotConfig.Price != nil {
+			res += "=" + *node.SpotConfig.Price
+		}
+	}
+	return res
+}
+
+func nodeName(node sdk.Node) string {
+	if node.Name != nil {
+		return *node.Name
+	}
+	if node.Id != nil {
+		return *node.Id
+	}
+	return ""
+}
+
+func nodePhase(node sdk.Node) string {
+	if node.State != nil && node.State.Phase != nil {
+		return *node.State.Phase
+	}
+	return ""
+}
+
+func vpnTypeName(vpn *sdk.VpnConfig) string {
+	switch {
+	case vpn == nil:
+	case vpn.WireGuard != nil && vpn.WireGuard.Topology == "fullMesh":
+		return vpnTypeWireGuardFullMesh
+	case vpn.WireGuard != nil && vpn.WireGuard.Topology == "crossLocationMesh":
+		return vpnTypeWireGuardCrossLocationMesh
+	case vpn.IpSec != nil:
+		return vpnTypeCloudProvider
+	}
+	return "none"
+}
+
+func vpcCidr(cfg *sdk.CloudNetworkConfig) string {
+	if cfg == nil || cfg.VpcCidr == "" {
+		return "default"
+	}
+	return cfg.VpcCidr
+}
+
+// credentialsNames maps cloud credential IDs to names.
+func credentialsNames(lists *clusterCreationSelectLists, ids []string) []string {
+	res := make([]string, len(ids))
+	for i, id := range ids {
+		res[i] = id
+		for _, option := range lists.credentials {
+			if option.extra["id"] == id {
+				res[i] = option.name
+				break
+			}
+		}
+	}
+	return res
+}
+
+func sameStringSet(a, b []string) bool {
+	x := append([]string{}, a...)
+	y := append([]string{}, b...)
+	sort.Strings(x)
+	sort.Strings(y)
+	return reflect.DeepEqual(x, y)
+}
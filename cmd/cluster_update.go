@@ -0,0 +1,237 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+type clusterUpdateOptions struct {
+	AttachCredentials  []string
+	DetachCredentials  []string
+	VPN                string
+	PrivateWorkerNodes *bool
+	AWSVPCCidr         string
+	GCPVPCCidr         string
+	AzureVPCCidr       string
+	DOVPCCidr          string
+	Confirm            bool
+}
+
+func newClusterUpdateCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := clusterUpdateOptions{}
+	var privateWorkerNodes bool
+	cmd := &cobra.Command{
+		Use:   "update [cluster_name_or_id]",
+		Short: "Update cluster credentials and network",
+		Long: `
+Attaches or detaches cloud credentials and changes cluster network. Fields which are not passed
+are left unchanged. Planned changes are shown before applying them. Credentials can be detached
+only when cluster has no nodes in their cloud.
+
+Examples:
+  # Expand cluster to gcp cloud.
+  cast cluster update my-cluster --attach-credentials=gcp --vpn=wireguard_cross_location_mesh
+
+  # Detach azure credentials and enable private worker nodes without confirmation.
+  cast cluster update my-cluster --detach-credentials=azure --private-worker-nodes --yes
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if cmd.Flags().Changed("private-worker-nodes") {
+				opts.PrivateWorkerNodes = &privateWorkerNodes
+			}
+			if err := handleClusterUpdate(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringSliceVar(&opts.AttachCredentials, "attach-credentials", []string{}, "cloud credentials names to attach, eg. --attach-credentials=gcp")
+	cmd.PersistentFlags().StringSliceVar(&opts.DetachCredentials, "detach-credentials", []string{}, "cloud credentials names to detach, eg. --detach-credentials=azure")
+	cmd.PersistentFlags().StringVar(&opts.VPN, "vpn", "", "virtual private network type between clouds, available values: cloud_provider, wireguard_cross_location_mesh, wireguard_full_mesh")
+	cmd.PersistentFlags().BoolVar(&privateWorkerNodes, "private-worker-nodes", false, "if set to true NAT Gateway will be provisioned for worker nodes egress traffic")
+	cmd.PersistentFlags().StringVar(&opts.AWSVPCCidr, "aws-vpc-cidr", "", "optional custom AWS VPC IPv4 CIDR, eg. --aws-vpc-cidr=10.10.0.0/16")
+	cmd.PersistentFlags().StringVar(&opts.GCPVPCCidr, "gcp-vpc-cidr", "", "optional custom GCP VPC IPv4 CIDR, eg. --gcp-vpc-cidr=10.0.0.0/16")
+	cmd.PersistentFlags().StringVar(&opts.AzureVPCCidr, "azure-vpc-cidr", "", "optional custom AZURE VPC IPv4 CIDR, eg. --azure-vpc-cidr=10.20.0.0/16")
+	cmd.PersistentFlags().StringVar(&opts.DOVPCCidr, "do-vpc-cidr", "", "optional custom DO IPv4 CIDR, eg. --do-vpc-cidr=10.100.0.0/16")
+	cmd.PersistentFlags().BoolVarP(&opts.Confirm, "yes", "y", false, "apply changes without confirmation")
+	return cmd
+}
+
+func handleClusterUpdate(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts clusterUpdateOptions) error {
+	ctx := cmd.Context()
+	cluster, err := getClusterFromArgs(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	lists := &clusterCreationSelectLists{}
+	if err := lists.load(ctx, api); err != nil {
+		return err
+	}
+
+	if len(opts.DetachCredentials) > 0 {
+		nodes, err := api.ListClusterNodes(ctx, sdk.ClusterId(cluster.Id))
+		if err != nil {
+			return err
+		}
+		if err := checkDetachedCredentialsUnused(lists, nodes, opts.DetachCredentials); err != nil {
+			return err
+		}
+	}
+
+	plan, err := buildClusterUpdatePlan(lists, cluster, opts)
+	if err != nil {
+		return err
+	}
+
+	printClusterPlan(cmd.OutOrStdout(), plan)
+	if plan.empty() {
+		return nil
+	}
+
+	if !opts.Confirm {
+		if err := survey.AskOne(&survey.Confirm{
+			Message: "Apply these changes?",
+		}, &opts.Confirm); err != nil {
+			return err
+		}
+	}
+
+	if !opts.Confirm {
+		log.Info("Cluster update canceled")
+		return nil
+	}
+
+	if _, err := api.UpdateCluster(ctx, sdk.ClusterId(cluster.Id), *plan.Update); err != nil {
+		return err
+	}
+	log.Infof("Cluster %s credentials and network updated", cluster.Name)
+	return nil
+}
+
+// buildClusterUpdatePlan applies update options on top of live cluster credentials and network.
+func buildClusterUpdatePlan(lists *clusterCreationSelectLists, cluster *sdk.KubernetesCluster, opts clusterUpdateOptions) (*clusterPlan, error) {
+	credentialIDs, err := updateCredentialIDs(lists, cluster.CloudCredentialsIDs, opts.AttachCredentials, opts.DetachCredentials)
+	if err != nil {
+		return nil, err
+	}
+
+	live := cluster.Network
+	if live == nil {
+		live = &sdk.Network{}
+	}
+	networkOpts := clusterCreateOptions{
+		VPN:                opts.VPN,
+		PrivateWorkerNodes: live.PrivateWorkerNodes,
+		AWSVPCCidr:         opts.AWSVPCCidr,
+		GCPVPCCidr:         opts.GCPVPCCidr,
+		AzureVPCCidr:       opts.AzureVPCCidr,
+		DOVPCCidr:          opts.DOVPCCidr,
+	}
+	// Keep current VPN when it is not changed, it is still validated against new clouds count.
+	if networkOpts.VPN == "" && live.Vpn != nil {
+		networkOpts.VPN = vpnTypeName(live.Vpn)
+	}
+	if opts.PrivateWorkerNodes != nil {
+		networkOpts.PrivateWorkerNodes = *opts.PrivateWorkerNodes
+	}
+	desired, err := toNetwork(lists, networkOpts, len(credentialIDs))
+	if err != nil {
+		return nil, err
+	}
+	network := mergeNetwork(cluster.Network, desired)
+
+	plan := &clusterPlan{Name: cluster.Name}
+	if !sameStringSet(cluster.CloudCredentialsIDs, credentialIDs) {
+		plan.Changes = append(plan.Changes, clusterFieldChange{
+			Field: "credentials",
+			From:  strings.Join(credentialsNames(lists, cluster.CloudCredentialsIDs), ", "),
+			To:    strings.Join(credentialsNames(lists, credentialIDs), ", "),
+		})
+	}
+	plan.Changes = append(plan.Changes, diffNetwork(cluster.Network, network)...)
+	if len(plan.Changes) > 0 {
+		plan.Update = &sdk.UpdateClusterJSONRequestBody{
+			CloudCredentialsIDs: credentialIDs,
+			Network:             network,
+		}
+	}
+	return plan, nil
+}
+
+// checkDetachedCredentialsUnused returns error if cluster still has nodes in the cloud of detached credentials.
+func checkDetachedCredentialsUnused(lists *clusterCreationSelectLists, nodes []sdk.Node, detach []string) error {
+	for _, name := range detach {
+		v, ok := lists.credentials.find(name)
+		if !ok {
+			continue
+		}
+		cloud := v.extra["cloud"]
+		count := 0
+		for _, node := range nodes {
+			if phase := nodePhase(node); phase == "deleting" || phase == "deleted" {
+				continue
+			}
+			if string(node.Cloud) == cloud {
+				count++
+			}
+		}
+		if count > 0 {
+			return fmt.Errorf("cloud credentials %s can't be detached, cluster still has %d %s nodes, delete them first", name, count, cloud)
+		}
+	}
+	return nil
+}
+
+// updateCredentialIDs attaches and detaches cloud credentials by names and returns resulting credential IDs.
+func updateCredentialIDs(lists *clusterCreationSelectLists, current, attach, detach []string) ([]string, error) {
+	res := append([]string{}, current...)
+	for _, name := range attach {
+		v, ok := lists.credentials.find(name)
+		if !ok {
+			return nil, fmt.Errorf("cloud credentials value '%s' is not valid, available values: %s", name, strings.Join(lists.credentials.names(), ", "))
+		}
+		if indexOfString(res, v.extra["id"]) >= 0 {
+			return nil, fmt.Errorf("cloud credentials %s are already attached to cluster", name)
+		}
+		res = append(res, v.extra["id"])
+	}
+	for _, name := range detach {
+		v, ok := lists.credentials.find(name)
+		if !ok {
+			return nil, fmt.Errorf("cloud credentials value '%s' is not valid, available values: %s", name, strings.Join(lists.credentials.names(), ", "))
+		}
+		i := indexOfString(res, v.extra["id"])
+		if i < 0 {
+			return nil, fmt.Errorf("cloud credentials %s are not attached to cluster", name)
+		}
+		res = append(res[:i], res[i+1:]...)
+	}
+	if len(res) == 0 {
+		return nil, errors.New("cluster should have at least one cloud credentials")
+	}
+	return res, nil
+}
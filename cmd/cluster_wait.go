@@ -0,0 +1,243 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+var clusterWaitPollInterval = 10 * time.Second
+
+// clusterWaitMaxErrors is max number of consecutive cluster or nodes get errors tolerated while waiting.
+const clusterWaitMaxErrors = 5
+
+// clusterChangeWaitTimeout limits waiting for cluster deletion and reconcile. Cluster creation is not limited
+// as provisioning time depends on clouds and nodes count.
+const clusterChangeWaitTimeout = 30 * time.Minute
+
+// clusterWaitFunc returns true when cluster reached desired state or error if it never will. Cluster is nil
+// when it no longer exists.
+type clusterWaitFunc func(cluster *sdk.KubernetesCluster) (bool, error)
+
+// waitClusterWithProgress polls cluster until done returns true and prints new cluster feedback events meanwhile.
+// Action names what is being waited for in errors, zero timeout means no limit.
+func waitClusterWithProgress(ctx context.Context, log logrus.FieldLogger, api client.Interface, events *clusterEventsPrinter, action string, timeout time.Duration, done clusterWaitFunc) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	errCount := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for cluster %s: %w", action, ctx.Err())
+		case <-time.After(clusterWaitPollInterval):
+			cluster, err := api.GetCluster(ctx, sdk.ClusterId(events.clusterID))
+			if client.IsNotFound(err) {
+				cluster, err = nil, nil
+			}
+			if err != nil {
+				errCount++
+				if errCount >= clusterWaitMaxErrors {
+					return fmt.Errorf("getting cluster status: %w", err)
+				}
+				log.Warn(err)
+				continue
+			}
+			errCount = 0
+			ok, err := done(cluster)
+			if err != nil {
+				events.print(ctx)
+				return err
+			}
+			if ok {
+				return nil
+			}
+			events.print(ctx)
+		}
+	}
+}
+
+// waitOperationWithProgress waits until cluster operation is done and prints new cluster feedback events meanwhile.
+func waitOperationWithProgress(ctx context.Context, api client.Interface, events *clusterEventsPrinter, operationID string) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultOperationWaitTimeout)
+	defer cancel()
+
+	errc := make(chan error, 1)
+	go func() {
+		_, err := client.WaitOperation(ctx, api.GetOperation, operationID)
+		errc <- err
+	}()
+
+	for {
+		select {
+		case err := <-errc:
+			return err
+		case <-time.After(clusterWaitPollInterval):
+			events.print(ctx)
+		}
+	}
+}
+
+// waitNodesDeletedWithProgress waits until nodes are removed from cluster nodes list and prints new cluster
+// feedback events meanwhile.
+func waitNodesDeletedWithProgress(ctx context.Context, api client.Interface, events *clusterEventsPrinter, nodeIDs []string) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultOperationWaitTimeout)
+	defer cancel()
+
+	for {
+		nodes, err := api.ListClusterNodes(ctx, sdk.ClusterId(events.clusterID))
+		if err != nil {
+			return err
+		}
+		remaining := 0
+		for _, node := range nodes {
+			for _, id := range nodeIDs {
+				if node.Id != nil && *node.Id == id {
+					remaining++
+				}
+			}
+		}
+		if remaining == 0 {
+			return nil
+		}
+		events.print(ctx)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for %d nodes deletion: %w", remaining, ctx.Err())
+		case <-time.After(clusterWaitPollInterval):
+		}
+	}
+}
+
+// waitNodeReplacedWithProgress waits until new ready node of the same cloud and role as interrupted node appears
+// in cluster nodes list and prints new cluster feedback events meanwhile. Nodes listed before interruption are
+// passed as existing and are never treated as replacement.
+func waitNodeReplacedWithProgress(ctx context.Context, log logrus.FieldLogger, api client.Interface, events *clusterEventsPrinter, interrupted sdk.Node, existing []sdk.Node) (*sdk.Node, error) {
+	ctx, cancel := context.WithTimeout(ctx, defaultOperationWaitTimeout)
+	defer cancel()
+
+	known := make(map[string]struct{}, len(existing))
+	for _, node := range existing {
+		if node.Id != nil {
+			known[*node.Id] = struct{}{}
+		}
+	}
+
+	errCount := 0
+	for {
+		nodes, err := api.ListClusterNodes(ctx, sdk.ClusterId(events.clusterID))
+		if err != nil {
+			// Node is already interrupted, so transient errors shouldn't stop waiting.
+			errCount++
+			if errCount >= clusterWaitMaxErrors {
+				return nil, fmt.Errorf("listing cluster nodes: %w", err)
+			}
+			log.Warn(err)
+		} else {
+			errCount = 0
+		}
+		for _, node := range nodes {
+			if node.Id == nil || node.Cloud != interrupted.Cloud || node.Role != interrupted.Role {
+				continue
+			}
+			if _, ok := known[*node.Id]; ok {
+				continue
+			}
+			if nodePhase(node) == "ready" {
+				return &node, nil
+			}
+		}
+		events.print(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for node %s replacement: %w", nodeValueString(interrupted.Name), ctx.Err())
+		case <-time.After(clusterWaitPollInterval):
+		}
+	}
+}
+
+// clusterStatusError returns error if cluster is in failed status.
+func clusterStatusError(cluster *sdk.KubernetesCluster) error {
+	if cluster.Status == "failed" {
+		return fmt.Errorf("cluster %s status is %s", cluster.Name, cluster.Status)
+	}
+	return nil
+}
+
+// clusterEventsPrinter prints cluster feedback events which were not printed yet. Waits on existing clusters
+// should call skipExisting before changing the cluster, otherwise whole cluster events history is printed.
+type clusterEventsPrinter struct {
+	log       logrus.FieldLogger
+	api       client.Interface
+	clusterID string
+	written   map[string]struct{}
+}
+
+func newClusterEventsPrinter(log logrus.FieldLogger, api client.Interface, clusterID string) *clusterEventsPrinter {
+	return &clusterEventsPrinter{
+		log:       log,
+		api:       api,
+		clusterID: clusterID,
+		written:   make(map[string]struct{}),
+	}
+}
+
+// skipExisting marks current cluster events as printed.
+func (p *clusterEventsPrinter) skipExisting(ctx context.Context) {
+	events, err := p.api.GetClusterFeedbackEvents(ctx, sdk.ClusterId(p.clusterID))
+	if err != nil {
+		p.log.Warn(err)
+		return
+	}
+	for _, e := range events {
+		p.written[e.Id] = struct{}{}
+	}
+}
+
+func (p *clusterEventsPrinter) print(ctx context.Context) {
+	events, err := p.api.GetClusterFeedbackEvents(ctx, sdk.ClusterId(p.clusterID))
+	if err != nil {
+		p.log.Warn(err)
+		return
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].CreatedAt.Before(events[j].CreatedAt)
+	})
+	for _, e := range events {
+		if _, ok := p.written[e.Id]; !ok {
+			logFn := p.log.Infof
+			if e.Severity == "error" {
+				logFn = p.log.Errorf
+			}
+			logFn("%s %s", e.CreatedAt.Format(time.RFC3339), e.Message)
+			p.written[e.Id] = struct{}{}
+		}
+	}
+}
@@ -3,16 +3,23 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"net/http"
 	"os"
 	"path"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
 	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
 	"github.com/castai/cli/pkg/config"
 	"github.com/castai/cli/pkg/ssh"
 )
@@ -70,6 +77,293 @@ func TestCommands(t *testing.T) {
 		fmt.Println(out)
 	})
 
+	t.Run("cluster create with price estimate", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(
+			root,
+			"cluster",
+			"create",
+			"--name", "test",
+			"--region", "eu-central",
+			"--credentials", "aws",
+			"--configuration", "basic",
+			"--estimate",
+			"--yes",
+		)
+		require.NoError(t, err)
+		fmt.Println(out)
+		expected := ` CLOUD  UNITS  HOURLY      MONTHLY   
+ AWS        2  0.1000 USD  72.00 USD 
+
+ COMPONENT  UNITS  HOURLY      MONTHLY   
+ master         1  0.0600 USD  43.20 USD 
+ worker         1  0.0400 USD  28.80 USD 
+
+Estimated total: 0.1000 USD per hour, 72.00 USD per month
+`
+		require.Equal(t, expected, out)
+	})
+
+	t.Run("cluster apply converges existing cluster", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		specPath := path.Join(t.TempDir(), "cluster.yaml")
+		spec := `apiVersion: v1
+kind: Cluster
+name: test-cluster-1
+region: eu-central
+credentials:
+  - aws
+  - gcp
+nodes:
+  - aws-master-medium
+  - gcp-worker-small
+network:
+  vpn: wireguard_full_mesh
+`
+		require.NoError(t, ioutil.WriteFile(specPath, []byte(spec), 0600))
+
+		out, err := executeCommand(root, "cluster", "apply", "-f", specPath, "--yes")
+		require.NoError(t, err)
+		fmt.Println(out)
+		expected := `Cluster test-cluster-1 will be updated:
+  ~ credentials: aws -> aws, gcp
+  ~ vpn: none -> wireguard_full_mesh
+  + node aws-master-medium
+  + node gcp-worker-small
+  ! node node1 (aws-master@t3a.large) is not in the spec, use --prune to delete it
+`
+		require.Equal(t, expected, out)
+
+		out, err = executeCommand(root, "cluster", "apply", "-f", specPath, "--yes", "--prune")
+		require.NoError(t, err)
+		expected = `Cluster test-cluster-1 will be updated:
+  - node node1 (aws-master@t3a.large)
+`
+		require.Equal(t, expected, out)
+
+		out, err = executeCommand(root, "cluster", "apply", "-f", specPath, "--yes")
+		require.NoError(t, err)
+		require.Equal(t, "Cluster test-cluster-1 is up to date\n", out)
+
+		out, err = executeCommand(root, "cluster", "diff", "-f", specPath)
+		require.NoError(t, err)
+		require.Equal(t, "Cluster test-cluster-1 is up to date\n", out)
+	})
+
+	t.Run("cluster export round trips applied spec", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		specPath := path.Join(t.TempDir(), "cluster.yaml")
+		spec := `apiVersion: v1
+kind: Cluster
+name: test-cluster-1
+region: eu-central
+credentials: [aws, gcp]
+nodes: [aws-master-medium, aws-worker-small, gcp-worker-large]
+network:
+  vpn: wireguard_cross_location_mesh
+  privateWorkerNodes: true
+  awsVpcCidr: 10.10.0.0/16
+`
+		require.NoError(t, ioutil.WriteFile(specPath, []byte(spec), 0600))
+		_, err := executeCommand(root, "cluster", "apply", "-f", specPath, "--yes")
+		require.NoError(t, err)
+		// Replaced master node can be pruned only after new master is added.
+		_, err = executeCommand(root, "cluster", "apply", "-f", specPath, "--yes", "--prune")
+		require.NoError(t, err)
+
+		out, err := executeCommand(root, "cluster", "export", "test-cluster-1")
+		require.NoError(t, err)
+		fmt.Println(out)
+		exported, err := parseClusterSpec([]byte(out))
+		require.NoError(t, err)
+		expected, err := parseClusterSpec([]byte(spec))
+		require.NoError(t, err)
+		require.Equal(t, expected, exported)
+
+		out, err = executeCommand(root, "cluster", "export", "test-cluster-1", "--format", "flags", "--name", "clone", "--region", "us-east")
+		require.NoError(t, err)
+		expectedFlags := `cast cluster create \
+  --name=clone \
+  --region=us-east \
+  --credentials=aws,gcp \
+  --node=aws-master-medium \
+  --node=aws-worker-small \
+  --node=gcp-worker-large \
+  --vpn=wireguard_cross_location_mesh \
+  --private-worker-nodes \
+  --aws-vpc-cidr=10.10.0.0/16
+`
+		require.Equal(t, expectedFlags, out)
+	})
+
+	t.Run("cluster apply creates missing cluster", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		specPath := path.Join(t.TempDir(), "cluster.json")
+		spec := `{"apiVersion": "v1", "kind": "Cluster", "name": "new-cluster", "region": "eu-central", "credentials": ["aws"], "configuration": "basic"}`
+		require.NoError(t, ioutil.WriteFile(specPath, []byte(spec), 0600))
+
+		out, err := executeCommand(root, "cluster", "apply", "-f", specPath, "--yes")
+		require.NoError(t, err)
+		fmt.Println(out)
+		expected := `Cluster new-cluster will be created:
+  + region: eu-central
+  + node aws-master-medium
+  + node aws-worker-small
+`
+		require.Equal(t, expected, out)
+
+		out, err = executeCommand(root, "cluster", "get", "new-cluster")
+		require.NoError(t, err)
+		require.Contains(t, out, "new-cluster")
+	})
+
+	t.Run("cluster pause and resume", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		_, err := executeCommand(root, "cluster", "pause", "test-cluster-1", "--notes", "weekend")
+		require.NoError(t, err)
+		out, err := executeCommand(root, "cluster", "get", "test-cluster-1")
+		require.NoError(t, err)
+		require.Contains(t, out, "paused")
+
+		_, err = executeCommand(root, "cluster", "resume", "test-cluster-1")
+		require.NoError(t, err)
+		out, err = executeCommand(root, "cluster", "get", "test-cluster-1")
+		require.NoError(t, err)
+		require.Contains(t, out, "ready")
+	})
+
+	t.Run("cluster schedule set, get and delete", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		_, err := executeCommand(root, "cluster", "schedule", "set", "test-cluster-1", "--tz", "Europe/Vilnius", "--span", "mon-wed,08:00-19:00", "--span", "sat,10:00-14:00")
+		require.NoError(t, err)
+
+		out, err := executeCommand(root, "cluster", "schedule", "get", "test-cluster-1")
+		require.NoError(t, err)
+		fmt.Println(out)
+		expected := `Status: enabled
+Time zone: Europe/Vilnius
+ DAY        ACTIVE_FROM  ACTIVE_TO 
+ Monday     08:00        19:00     
+ Tuesday    08:00        19:00     
+ Wednesday  08:00        19:00     
+ Saturday   10:00        14:00     
+`
+		require.Equal(t, expected, out)
+
+		_, err = executeCommand(root, "cluster", "schedule", "delete", "test-cluster-1")
+		require.NoError(t, err)
+	})
+
+	t.Run("policies get", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "policies", "get", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		fmt.Println(out)
+		expected := `clusterLimits:
+  cpu:
+    maxCores: 20
+    minCores: 2
+  enabled: true
+enabled: true
+spotInstances:
+  clouds:
+  - aws
+  enabled: true
+unschedulablePods:
+  enabled: true
+  headroom:
+    cpuPercentage: 10
+    memoryPercentage: 10
+`
+		require.Equal(t, expected, out)
+	})
+
+	t.Run("policies set and edit", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		policiesPath := path.Join(t.TempDir(), "policies.json")
+		policies := `{"enabled": true, "clusterLimits": {"enabled": true, "cpu": {"minCores": 4, "maxCores": 8}}, "unschedulablePods": {"headroom": {"cpuPercentage": 20}}}`
+		require.NoError(t, ioutil.WriteFile(policiesPath, []byte(policies), 0600))
+		_, err := executeCommand(root, "policies", "set", "-c", "test-cluster-1", "-f", policiesPath)
+		require.NoError(t, err)
+
+		os.Setenv("EDITOR", "sed -i -e s/maxCores:.*/maxCores:\\x2016/")
+		defer os.Unsetenv("EDITOR")
+		_, err = executeCommand(root, "policies", "edit", "-c", "test-cluster-1")
+		require.NoError(t, err)
+
+		out, err := executeCommand(root, "policies", "get", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		require.Contains(t, out, "maxCores: 16\n    minCores: 4\n")
+		require.Contains(t, out, "cpuPercentage: 20\n")
+	})
+
+	t.Run("external list", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "external", "list")
+		require.NoError(t, err)
+		fmt.Println(out)
+		expected := ` ID                                    NAME      STATUS  PROVIDER  CLUSTER_NAME  REGION       
+ 33333333-3333-3333-3333-333333333333  eks-prod  ready   eks       prod          eu-central-1 
+`
+		require.Equal(t, expected, out)
+	})
+
+	t.Run("external register, pause, resume and delete", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "external", "register", "--name", "gke-dev", "--provider", "gke", "--region", "europe-west3", "--credentials", "gcp")
+		require.NoError(t, err)
+		require.Equal(t, "external-token\n", out)
+
+		_, err = executeCommand(root, "external", "pause", "gke-dev")
+		require.NoError(t, err)
+		out, err = executeCommand(root, "external", "get", "gke-dev")
+		require.NoError(t, err)
+		require.Contains(t, out, "gke-dev  paused  gke       gke-dev       europe-west3")
+
+		_, err = executeCommand(root, "external", "resume", "gke-dev")
+		require.NoError(t, err)
+		_, err = executeCommand(root, "external", "delete", "gke-dev", "--yes")
+		require.NoError(t, err)
+	})
+
+	t.Run("external node list", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "external", "node", "list", "-c", "eks-prod")
+		require.NoError(t, err)
+		fmt.Println(out)
+		expected := ` ID                                    NAME                                        CLOUD  INSTANCE_TYPE  ZONE           LIFECYCLE 
+ 44444444-4444-4444-4444-444444444444  ip-10-0-1-10.eu-central-1.compute.internal  aws    m5.large       eu-central-1a  on-demand 
+`
+		require.Equal(t, expected, out)
+	})
+
+	t.Run("external node add and delete with wait", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		_, err := executeCommand(root, "external", "node", "add", "-c", "eks-prod", "--instance-type", "m5.xlarge", "--zone", "eu-central-1b", "--spot", "--wait")
+		require.NoError(t, err)
+		out, err := executeCommand(root, "external", "node", "list", "-c", "eks-prod")
+		require.NoError(t, err)
+		require.Contains(t, out, "m5.xlarge      eu-central-1b  spot")
+
+		_, err = executeCommand(root, "external", "node", "delete", "ip-10-0-1-10.eu-central-1.compute.internal", "-c", "eks-prod", "--yes", "--wait")
+		require.NoError(t, err)
+		out, err = executeCommand(root, "external", "node", "list", "-c", "eks-prod")
+		require.NoError(t, err)
+		require.NotContains(t, out, "ip-10-0-1-10")
+	})
+
 	t.Run("cluster get by cluster name", func(t *testing.T) {
 		root := newTestRootCmd()
 
@@ -98,6 +392,55 @@ func TestCommands(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("cluster update", func(t *testing.T) {
+		// Flags keep values between executions, so each execution gets new root sharing the same api.
+		api := client.NewMock()
+		newRoot := func() *cobra.Command {
+			return NewRootCmd(logrus.New(), &config.Config{}, api, &mockTerminal{}, &mockIpify{})
+		}
+		clusterID := sdk.ClusterId("00000000-0000-0000-0000-000000000000")
+
+		out, err := executeCommand(newRoot(), "cluster", "update", "test-cluster-1", "--attach-credentials", "gcp", "--vpn", "wireguard_cross_location_mesh", "--yes")
+		require.NoError(t, err)
+		require.Equal(t, `Cluster test-cluster-1 will be updated:
+  ~ credentials: aws -> aws, gcp
+  ~ vpn: none -> wireguard_cross_location_mesh
+`, out)
+		cluster, err := api.GetCluster(context.Background(), clusterID)
+		require.NoError(t, err)
+		require.Len(t, cluster.CloudCredentialsIDs, 2)
+		require.Equal(t, "wireguard_cross_location_mesh", vpnTypeName(cluster.Network.Vpn))
+
+		out, err = executeCommand(newRoot(), "cluster", "update", "test-cluster-1", "--private-worker-nodes", "--yes")
+		require.NoError(t, err)
+		require.Equal(t, `Cluster test-cluster-1 will be updated:
+  ~ privateWorkerNodes: false -> true
+`, out)
+
+		out, err = executeCommand(newRoot(), "cluster", "update", "test-cluster-1")
+		require.NoError(t, err)
+		require.Equal(t, "Cluster test-cluster-1 is up to date\n", out)
+	})
+
+	t.Run("cluster ingress", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "cluster", "ingress", "test-cluster-1")
+		require.NoError(t, err)
+		require.Equal(t, ` TYPE  ADDRESS  PORTS  
+ aws   1.1.1.1  80,443 
+`, out)
+	})
+
+	t.Run("gslb set and delete", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		_, err := executeCommand(root, "gslb", "set", "-c", "test-cluster-1", "--namespace", "web", "--service", "frontend")
+		require.NoError(t, err)
+		_, err = executeCommand(root, "gslb", "delete", "-c", "test-cluster-1", "--namespace", "web", "--service", "frontend", "--yes")
+		require.NoError(t, err)
+	})
+
 	t.Run("cluster delete", func(t *testing.T) {
 		root := newTestRootCmd()
 
@@ -109,6 +452,98 @@ func TestCommands(t *testing.T) {
 		fmt.Println(out)
 	})
 
+	t.Run("cluster history", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "cluster", "history", "test-cluster-1", "--since", "2021-01-02")
+		require.NoError(t, err)
+		require.Equal(t, ` TIME                  OPERATION  METADATA                                              
+ 2021-01-02T12:00:00Z  nodeAdded  labels.0=a labels.1=b node.cloud=aws node.role=master 
+`, out)
+	})
+
+	t.Run("cluster delete and archive", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		_, err := executeCommand(root, "cluster", "delete", "test-cluster-1", "-y")
+		require.NoError(t, err)
+		out, err := executeCommand(root, "cluster", "list")
+		require.NoError(t, err)
+		require.NotContains(t, out, "test-cluster-1")
+		out, err = executeCommand(root, "cluster", "list", "--include-deleted")
+		require.NoError(t, err)
+		require.Contains(t, out, "test-cluster-1")
+
+		_, err = executeCommand(root, "cluster", "archive", "test-cluster-1", "-y")
+		require.NoError(t, err)
+		out, err = executeCommand(root, "cluster", "list", "--include-deleted")
+		require.NoError(t, err)
+		require.NotContains(t, out, "test-cluster-1")
+	})
+
+	t.Run("cluster reconcile and delete with wait", func(t *testing.T) {
+		defer func(interval time.Duration) { clusterWaitPollInterval = interval }(clusterWaitPollInterval)
+		clusterWaitPollInterval = time.Millisecond
+		root := newTestRootCmd()
+
+		_, err := executeCommand(root, "cluster", "reconcile", "test-cluster-1", "--wait")
+		require.NoError(t, err)
+
+		_, err = executeCommand(root, "cluster", "delete", "test-cluster-1", "-y", "--wait")
+		require.NoError(t, err)
+		out, err := executeCommand(root, "cluster", "get", "test-cluster-1")
+		require.NoError(t, err)
+		require.Contains(t, out, "deleted")
+	})
+
+	t.Run("cluster health", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "cluster", "health", "test-cluster-1")
+		require.NoError(t, err)
+		fmt.Println(out)
+		require.Contains(t, out, "Kubernetes")
+		require.Contains(t, out, "node1  aws    master  127.0.0.1  1.1.1.1")
+	})
+
+	t.Run("cluster metrics", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "cluster", "metrics", "test-cluster-1", "--type", "node_cpu_usage")
+		require.NoError(t, err)
+		fmt.Println(out)
+		expected := ` NAME   CURRENT  MIN   MAX   TREND 
+ node1  0.75     0.50  1.00  ▁█▄   
+`
+		require.Equal(t, expected, out)
+	})
+
+	t.Run("usage report", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "usage", "report", "--from", "2021-01-01", "--to", "2021-01-03")
+		require.NoError(t, err)
+		fmt.Println(out)
+		require.Contains(t, out, "test-cluster-1  2021-01-01T00:00:00Z  2021-01-02T00:00:00Z         48            98304")
+		require.Contains(t, out, "TOTAL")
+
+		out, err = executeCommand(newTestRootCmd(), "usage", "report", "-c", "test-cluster-1", "--from", "2021-01-01", "--to", "2021-01-03", "--format", "csv")
+		require.NoError(t, err)
+		expected := `cluster_id,cluster,from,to,cpu_hours,memory_mb_hours
+00000000-0000-0000-0000-000000000000,test-cluster-1,2021-01-01T00:00:00Z,2021-01-02T00:00:00Z,48,98304
+00000000-0000-0000-0000-000000000000,test-cluster-1,2021-01-02T00:00:00Z,2021-01-03T00:00:00Z,24,49152
+,total,2021-01-01T00:00:00Z,2021-01-03T00:00:00Z,72,147456
+`
+		require.Equal(t, expected, out)
+
+		out, err = executeCommand(newTestRootCmd(), "usage", "report", "-c", "test-cluster-1", "--from", "2021-01-01", "--to", "2021-01-03", "--format", "json")
+		require.NoError(t, err)
+		var report usageReport
+		require.NoError(t, json.Unmarshal([]byte(out), &report))
+		require.Len(t, report.Rows, 2)
+		require.Equal(t, usageReportTotal{From: "2021-01-01T00:00:00Z", To: "2021-01-03T00:00:00Z", CPUHours: 72, MemoryMBHours: 147456}, report.Total)
+	})
+
 	t.Run("node list", func(t *testing.T) {
 		root := newTestRootCmd()
 
@@ -142,6 +577,120 @@ func TestCommands(t *testing.T) {
 		fmt.Println(out)
 	})
 
+	t.Run("node add and delete with wait", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		_, err := executeCommand(root, "node", "add", "--cloud", "gcp", "--role", "worker", "--shape", "large", "-c", "test-cluster-1", "--wait")
+		require.NoError(t, err)
+		out, err := executeCommand(root, "node", "list", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		require.Contains(t, out, "gcp-worker-")
+
+		_, err = executeCommand(root, "node", "delete", "node1", "--yes", "-c", "test-cluster-1", "--wait")
+		require.NoError(t, err)
+		out, err = executeCommand(root, "node", "list", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		require.NotContains(t, out, "node1")
+	})
+
+	t.Run("node delete with drain timeout", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		_, err := executeCommand(root, "node", "delete", "node1", "--yes", "-c", "test-cluster-1", "--drain-timeout", "10m", "--force", "--wait")
+		require.NoError(t, err)
+		out, err := executeCommand(root, "node", "list", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		require.NotContains(t, out, "node1")
+	})
+
+	t.Run("node interrupt", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		_, err := executeCommand(root, "node", "interrupt", "node1", "--yes", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		out, err := executeCommand(root, "node", "list", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		require.NotContains(t, out, "node1")
+		require.Contains(t, out, "aws-master-")
+
+		_, err = executeCommand(root, "node", "interrupt", "--random", "--role", "master", "--yes", "--wait", "-c", "test-cluster-1")
+		require.NoError(t, err)
+	})
+
+	t.Run("operation get and wait", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "operation", "get", "55555555-5555-5555-5555-555555555555")
+		require.NoError(t, err)
+		fmt.Println(out)
+		expected := ` ID                                    STATUS     CREATED   FINISHED  ERROR 
+ 55555555-5555-5555-5555-555555555555  succeeded  just now  just now        
+`
+		require.Equal(t, expected, out)
+
+		out, err = executeCommand(root, "operation", "wait", "55555555-5555-5555-5555-555555555555", "--timeout", "1m")
+		require.NoError(t, err)
+		require.Equal(t, expected, out)
+	})
+
+	t.Run("node add with instance type", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		_, err := executeCommand(root, "node", "add", "--cloud", "aws", "--role", "worker", "--instance-type", "m5.xlarge", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		out, err := executeCommand(root, "node", "list", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		require.Contains(t, out, "m5.xlarge")
+	})
+
+	t.Run("node add spot", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		_, err := executeCommand(root, "node", "add", "--cloud", "aws", "--role", "worker", "--shape", "large", "--spot", "--spot-max-price", "0.05", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		out, err := executeCommand(root, "node", "list", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		require.Contains(t, out, "aws    worker  large                     spot       creating")
+		require.Contains(t, out, "t3a.large  t3a.large      on-demand  ready")
+	})
+
+	t.Run("node scale and delete by selector", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		_, err := executeCommand(root, "node", "scale", "-c", "test-cluster-1", "--cloud", "gcp", "--shape", "large", "--count", "3", "--yes")
+		require.NoError(t, err)
+		out, err := executeCommand(root, "node", "list", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		require.Equal(t, 3, strings.Count(out, "gcp    worker  large"))
+
+		_, err = executeCommand(root, "node", "scale", "-c", "test-cluster-1", "--cloud", "gcp", "--shape", "large", "--count", "1", "--yes")
+		require.NoError(t, err)
+		out, err = executeCommand(root, "node", "list", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		require.Equal(t, 1, strings.Count(out, "gcp    worker  large"))
+
+		_, err = executeCommand(root, "node", "scale", "-c", "test-cluster-1", "--cloud", "aws", "--count", "2", "--yes")
+		require.NoError(t, err)
+		_, err = executeCommand(root, "node", "delete", "-c", "test-cluster-1", "--selector", "role=worker", "--yes", "--wait")
+		require.NoError(t, err)
+		out, err = executeCommand(root, "node", "list", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		require.NotContains(t, out, "worker")
+		require.Contains(t, out, "node1")
+	})
+
+	t.Run("instance types list", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "instance-types", "list", "--cloud", "aws", "--region", "eu-central-1", "--min-cpu", "4", "--max-price", "0.2", "--sort", "price")
+		require.NoError(t, err)
+		fmt.Println(out)
+		expected := ` NAME       CLOUD  REGION        VCPU  RAM_GIB  PRICE_HOURLY 
+ c5.xlarge  aws    eu-central-1     4  8.0      0.194        
+`
+		require.Equal(t, expected, out)
+	})
+
 	t.Run("node ssh", func(t *testing.T) {
 		root := newTestRootCmd()
 
@@ -176,6 +725,144 @@ func TestCommands(t *testing.T) {
  cred3  azure  azure         0`
 		require.Equal(t, expected+" \n", out)
 	})
+
+	t.Run("addon catalog", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "addon", "catalog")
+		require.NoError(t, err)
+		fmt.Println(out)
+		expected := ` NAME     VERSION  REPOSITORY       DESCRIPTION                    
+ grafana  6.1.0    official-addons  Dashboards for cluster metrics 
+ keda     2.0.0    official-addons  Event driven autoscaling      `
+		require.Equal(t, expected+" \n", out)
+	})
+
+	t.Run("addon install, upgrade and uninstall", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		_, err := executeCommand(root, "addon", "install", "grafana", "-c", "test-cluster-1", "--set", "replicas=2")
+		require.NoError(t, err)
+
+		out, err := executeCommand(root, "addon", "list", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		fmt.Println(out)
+		require.Contains(t, out, "grafana  6.1.0")
+
+		_, err = executeCommand(root, "addon", "upgrade", "grafana", "-c", "test-cluster-1", "--version", "6.2.0")
+		require.NoError(t, err)
+
+		out, err = executeCommand(root, "addon", "list", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		require.Contains(t, out, "grafana  6.2.0")
+
+		_, err = executeCommand(root, "addon", "uninstall", "grafana", "-c", "test-cluster-1", "-y")
+		require.NoError(t, err)
+	})
+
+	t.Run("audit list", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "audit", "list", "--limit", "1", "-c", "test-cluster-1")
+		require.NoError(t, err)
+		fmt.Println(out)
+		expected := ` TIME                  EVENT           INITIATED_BY  EMAIL            
+ 2021-01-01T12:00:00Z  clusterCreated  John Doe      john@example.com 
+ 2021-01-02T12:00:00Z  clusterDeleted  John Doe      john@example.com`
+		require.Equal(t, expected+" \n", out)
+	})
+
+	t.Run("audit list single page", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "audit", "list", "--limit", "1", "--cursor", "1")
+		require.NoError(t, err)
+		fmt.Println(out)
+		require.NotContains(t, out, "clusterCreated")
+		require.Contains(t, out, "clusterDeleted")
+	})
+
+	t.Run("credentials create, get and delete", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		credsPath := path.Join(t.TempDir(), "creds.json")
+		require.NoError(t, ioutil.WriteFile(credsPath, []byte(`{"accessKeyId":"key","secretAccessKey":"secret"}`), 0600))
+		_, err := executeCommand(root, "credentials", "create", "--cloud", "aws", "--name", "aws-prod", "--from-file", credsPath)
+		require.NoError(t, err)
+
+		out, err := executeCommand(root, "credentials", "get", "aws-prod")
+		require.NoError(t, err)
+		fmt.Println(out)
+		require.Contains(t, out, "aws-prod  aws")
+
+		_, err = executeCommand(root, "credentials", "delete", "aws-prod", "--yes")
+		require.NoError(t, err)
+	})
+
+	t.Run("credentials delete used by cluster with force", func(t *testing.T) {
+		api := &inUseCredentialsClient{
+			Interface: client.NewMock(),
+			credentials: &sdk.CloudCredentials{
+				Cloud:  "aws",
+				Id:     "cred-in-use",
+				Name:   "aws-in-use",
+				UsedBy: &[]sdk.CloudCredentialsReservation{{Id: "00000000-0000-0000-0000-000000000000", Name: "test-cluster-1"}},
+			},
+		}
+		root := NewRootCmd(logrus.New(), &config.Config{}, api, &mockTerminal{}, &mockIpify{})
+
+		_, err := executeCommand(root, "credentials", "delete", "aws-in-use", "--yes", "--force")
+		require.NoError(t, err)
+		require.Nil(t, api.credentials)
+	})
+
+	t.Run("token create, disable and revoke", func(t *testing.T) {
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "token", "create", "--name", "laptop")
+		require.NoError(t, err)
+		require.Equal(t, "secret-token\n", out)
+
+		_, err = executeCommand(root, "token", "disable", "laptop")
+		require.NoError(t, err)
+
+		out, err = executeCommand(root, "token", "list")
+		require.NoError(t, err)
+		fmt.Println(out)
+		require.Contains(t, out, "laptop  disabled  never")
+
+		_, err = executeCommand(root, "token", "revoke", "laptop", "--yes")
+		require.NoError(t, err)
+	})
+
+	t.Run("token create and save", func(t *testing.T) {
+		configPath := path.Join(t.TempDir(), "config")
+		os.Setenv("CASTAI_CONFIG", configPath)
+		defer os.Unsetenv("CASTAI_CONFIG")
+		os.Setenv("CASTAI_DEFAULT_REGION", "us-east")
+		defer os.Unsetenv("CASTAI_DEFAULT_REGION")
+		require.NoError(t, ioutil.WriteFile(configPath, []byte("hostname: api.example.com\n"), 0600))
+		root := newTestRootCmd()
+
+		out, err := executeCommand(root, "token", "create", "--name", "laptop", "--save")
+		require.NoError(t, err)
+		require.Empty(t, out)
+
+		cfg, err := config.LoadFromFile()
+		require.NoError(t, err)
+		require.Equal(t, "secret-token", cfg.AccessToken)
+		require.Equal(t, "api.example.com", cfg.Hostname)
+		require.Equal(t, "eu-central", cfg.DefaultRegion)
+	})
+}
+
+func TestParseAddonValuesOverrides(t *testing.T) {
+	res, err := parseAddonValuesOverrides([]string{"replicas=2", "image.args=a=b"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"replicas": "2", "image.args": "a=b"}, res)
+
+	_, err = parseAddonValuesOverrides([]string{"replicas"})
+	require.Error(t, err)
 }
 
 func executeCommand(root *cobra.Command, args ...string) (output string, err error) {
@@ -207,3 +894,753 @@ type mockIpify struct {
 func (m *mockIpify) GetPublicIP(ctx context.Context) (string, error) {
 	return "1.1.1.1", nil
 }
+
+func TestParseTimeFlag(t *testing.T) {
+	v, err := parseTimeFlag("2021-01-31T15:04:05Z")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2021, 1, 31, 15, 4, 5, 0, time.UTC), v)
+
+	v, err = parseTimeFlag("2021-01-31")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2021, 1, 31, 0, 0, 0, 0, time.UTC), v)
+
+	v, err = parseTimeFlag("7d")
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().AddDate(0, 0, -7), v, time.Minute)
+
+	v, err = parseTimeFlag("24h")
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(-24*time.Hour), v, time.Minute)
+
+	_, err = parseTimeFlag("yesterday")
+	require.Error(t, err)
+}
+
+func TestToCredentialsData(t *testing.T) {
+	res, err := toCredentialsData("do", []byte("do-token\n"))
+	require.NoError(t, err)
+	require.Equal(t, `{"token":"do-token"}`, res)
+
+	_, err = toCredentialsData("aws", []byte(`{"accessKeyId":"key"}`))
+	require.EqualError(t, err, `aws credentials field "secretAccessKey" is required`)
+
+	_, err = toCredentialsData("gcp", []byte(`{"type":"authorized_user","project_id":"p","private_key":"k","client_email":"e"}`))
+	require.Error(t, err)
+
+	_, err = toCredentialsData("azure", []byte("not json"))
+	require.Error(t, err)
+}
+
+func TestCheckCredentialsNotUsed(t *testing.T) {
+	require.NoError(t, checkCredentialsNotUsed(sdk.CloudCredentials{Name: "aws"}))
+
+	err := checkCredentialsNotUsed(sdk.CloudCredentials{
+		Name:   "aws",
+		UsedBy: &[]sdk.CloudCredentialsReservation{{Id: "c1", Name: "test-cluster-1"}},
+	})
+	require.EqualError(t, err, "credentials aws are used by clusters: test-cluster-1, pass --force to delete anyway")
+}
+
+func TestParseClusterSpec(t *testing.T) {
+	spec, err := parseClusterSpec([]byte(`{"apiVersion": "v1", "kind": "Cluster", "name": "demo", "nodes": ["aws-master-medium"], "network": {"awsVpcCidr": "10.10.0.0/16"}}`))
+	require.NoError(t, err)
+	require.Equal(t, "demo", spec.Name)
+	require.Equal(t, []string{"aws-master-medium"}, spec.Nodes)
+	require.Equal(t, "10.10.0.0/16", spec.Network.AWSVPCCidr)
+
+	_, err = parseClusterSpec([]byte("apiVersion: v2\nkind: Cluster\nname: demo\n"))
+	require.EqualError(t, err, `unsupported cluster spec apiVersion "v2", expected "v1"`)
+
+	_, err = parseClusterSpec([]byte("apiVersion: v1\nkind: Cluster\nname: demo\nnodez: []\n"))
+	require.Error(t, err)
+}
+
+func TestHandleClusterDiff(t *testing.T) {
+	specPath := path.Join(t.TempDir(), "cluster.yaml")
+	spec := `apiVersion: v1
+kind: Cluster
+name: test-cluster-1
+region: eu-central
+credentials: [aws]
+nodes: [aws-master-medium]
+network:
+  privateWorkerNodes: true
+`
+	require.NoError(t, ioutil.WriteFile(specPath, []byte(spec), 0600))
+
+	diff := func(api client.Interface, prune bool) (string, bool, error) {
+		var changed bool
+		cmd := &cobra.Command{
+			RunE: func(cmd *cobra.Command, args []string) (err error) {
+				changed, err = handleClusterDiff(cmd, api, specPath, prune)
+				return err
+			},
+		}
+		buf := new(bytes.Buffer)
+		cmd.SetOut(buf)
+		cmd.SetArgs([]string{})
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		err := cmd.ExecuteContext(context.Background())
+		return buf.String(), changed, err
+	}
+
+	out, changed, err := diff(&deletedClusterClient{client.NewMock()}, false)
+	require.NoError(t, err)
+	require.True(t, changed)
+	expected := `Cluster test-cluster-1 will be updated:
+  ~ privateWorkerNodes: false -> true
+  + node aws-master-medium
+  ! node node1 (aws-master@t3a.large) is not in the spec, use --prune to delete it
+
+Plan: cluster update, 1 nodes to add, 0 nodes to delete, 1 fields to change
+`
+	require.Equal(t, expected, out)
+
+	_, _, err = diff(client.NewMock(), true)
+	require.EqualError(t, err, "cluster test-cluster-1 can't be left without master nodes, keep at least one existing master node in the spec")
+}
+
+func TestParsePauseScheduleSpan(t *testing.T) {
+	spans, err := parsePauseScheduleSpan("mon-tue,thu,08:00-19:00")
+	require.NoError(t, err)
+	require.Equal(t, []sdk.PauseScheduleSpan{
+		{DayOfTheWeek: "Monday", ActiveFrom: "08:00", ActiveTo: "19:00"},
+		{DayOfTheWeek: "Tuesday", ActiveFrom: "08:00", ActiveTo: "19:00"},
+		{DayOfTheWeek: "Thursday", ActiveFrom: "08:00", ActiveTo: "19:00"},
+	}, spans)
+
+	for _, s := range []string{
+		"08:00-19:00",
+		"fri-mon,08:00-19:00",
+		"mon,8am-7pm",
+		"mon,19:00-08:00",
+		"mon,08:00-24:30",
+		"funday,08:00-19:00",
+	} {
+		_, err := parsePauseScheduleSpan(s)
+		require.Error(t, err, s)
+	}
+
+	req, err := toPauseSchedule(clusterScheduleSetOptions{TimeZone: "Europe/Vilnius", Spans: []string{"mon-fri,08:00-12:00", "fri,12:00-19:00"}})
+	require.NoError(t, err)
+	require.Len(t, req.Spans, 6)
+	require.Equal(t, sdk.PauseScheduleSpan{DayOfTheWeek: "Friday", ActiveFrom: "12:00", ActiveTo: "19:00"}, req.Spans[5])
+
+	_, err = toPauseSchedule(clusterScheduleSetOptions{TimeZone: "Europe/Vilnius", Spans: []string{"mon-fri,08:00-12:00", "fri,11:00-19:00"}})
+	require.EqualError(t, err, `spans "mon-fri,08:00-12:00" and "fri,11:00-19:00" overlap on Friday`)
+
+	_, err = toPauseSchedule(clusterScheduleSetOptions{TimeZone: "Mars/Olympus", Spans: []string{"mon,08:00-12:00"}})
+	require.Error(t, err)
+}
+
+func TestValidatePolicies(t *testing.T) {
+	_, err := parsePolicies([]byte("clusterLimits:\n  cpu:\n    minCores: 10\n    maxCores: 5\nunschedulablePods:\n  headroom:\n    cpuPercentage: 150\nspotInstances:\n  clouds: [aws, ibm]\n"))
+	require.EqualError(t, err, `invalid policies: clusterLimits.cpu.minCores (10) should be less than or equal to maxCores (5); `+
+		`unschedulablePods.headroom.cpuPercentage (150) should be between 0 and 100; `+
+		`spotInstances.clouds value "ibm" is not valid, allowed values: aws, azure, gcp, do`)
+
+	_, err = parsePolicies([]byte("clusterLimitz: {}\n"))
+	require.Error(t, err)
+
+	policies, err := parsePolicies([]byte("enabled: true\nclusterLimits:\n  cpu:\n    minCores: 1\n    maxCores: 5\n"))
+	require.NoError(t, err)
+	require.Equal(t, int64(5), policies.ClusterLimits.Cpu.MaxCores)
+}
+
+func TestToClusterHealthReport(t *testing.T) {
+	health := &sdk.ClusterHealth{
+		Cilium:     sdk.ClusterHealthCilium{State: "healthy"},
+		Kubernetes: sdk.ClusterHealthKubernetes{State: "healthy"},
+		Nodes: sdk.ClusterHealthNodes{Items: []sdk.ClusterHealthNode{
+			{Name: "ip-10-0-0-1", HostIp: "10.0.0.1", State: "healthy"},
+			{Name: "ip-10-0-0-2", HostIp: "35.0.0.2", State: "unhealthy"},
+		}},
+	}
+	str := func(v string) *string { return &v }
+	nodes := []sdk.Node{
+		{Id: str("n1"), Name: str("master-1"), Cloud: "aws", Role: "master", Network: &sdk.NodeNetwork{PrivateIp: "10.0.0.1", PublicIp: "3.0.0.1"}},
+		{Id: str("n2"), Name: str("worker-1"), Cloud: "gcp", Role: "worker", Network: &sdk.NodeNetwork{PrivateIp: "10.1.0.2", PublicIp: "35.0.0.2"}},
+	}
+
+	report := toClusterHealthReport(health, nodes)
+	require.False(t, report.Healthy)
+	require.Equal(t, []clusterHealthNode{
+		{ID: "n1", Name: "master-1", Cloud: "aws", Role: "master", HostIP: "10.0.0.1", PublicIP: "3.0.0.1", State: "healthy"},
+		{ID: "n2", Name: "worker-1", Cloud: "gcp", Role: "worker", HostIP: "35.0.0.2", PublicIP: "35.0.0.2", State: "unhealthy"},
+	}, report.Nodes)
+
+	health.Nodes.Items = health.Nodes.Items[:1]
+	require.True(t, toClusterHealthReport(health, nodes).Healthy)
+}
+
+func TestParseMetricsResult(t *testing.T) {
+	result := []map[string]interface{}{
+		{
+			"metric": map[string]interface{}{"cloud": "gcp"},
+			"value":  []interface{}{1609459200.0, "2.5"},
+		},
+		{
+			"metric": map[string]interface{}{"job": "kubelet", "zone": "a"},
+			"values": []interface{}{
+				[]interface{}{1609459200.0, "1"},
+				[]interface{}{1609459260.0, "3"},
+			},
+		},
+		{
+			"metric": map[string]interface{}{"cloud": "aws"},
+			"value":  []interface{}{1609459200.0, "1.5"},
+		},
+	}
+
+	series, err := parseMetricsResult(result)
+	require.NoError(t, err)
+	require.Equal(t, []metricSeries{
+		{Name: "aws", Labels: map[string]string{"cloud": "aws"}, Values: []float64{1.5}},
+		{Name: "gcp", Labels: map[string]string{"cloud": "gcp"}, Values: []float64{2.5}},
+		{Name: "job=kubelet,zone=a", Labels: map[string]string{"job": "kubelet", "zone": "a"}, Values: []float64{1, 3}},
+	}, series)
+
+	_, err = parseMetricsResult([]map[string]interface{}{{"value": []interface{}{1609459200.0, "NaN?"}}})
+	require.Error(t, err)
+
+	series, err = parseMetricsResult([]map[string]interface{}{{
+		"metric": map[string]interface{}{"cloud": "aws"},
+		"values": []interface{}{
+			[]interface{}{1609459200.0, "1"},
+			[]interface{}{1609459260.0, "+Inf"},
+			[]interface{}{1609459320.0, "NaN"},
+		},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []float64{1}, series[0].Values)
+	require.Equal(t, "▁", sparkline(series[0].Values))
+
+	history := map[string][]float64{}
+	appendMetricsHistory(history, []metricSeries{{Name: "aws", Values: []float64{1}}})
+	series = appendMetricsHistory(history, []metricSeries{{Name: "aws", Values: []float64{2}}})
+	require.Equal(t, []float64{1, 2}, series[0].Values)
+	series = appendMetricsHistory(history, []metricSeries{{Name: "aws"}})
+	require.Equal(t, []float64{1, 2}, series[0].Values)
+}
+
+func TestSparkline(t *testing.T) {
+	require.Equal(t, "▁▂▃▄▅▆▇█", sparkline([]float64{0, 1, 2, 3, 4, 5, 6, 7}))
+	require.Equal(t, "▁▁▁", sparkline([]float64{5, 5, 5}))
+}
+
+func TestUsageReportPeriod(t *testing.T) {
+	now := time.Date(2021, 3, 15, 10, 0, 0, 0, time.UTC)
+
+	from, to, err := usageReportPeriod(usageReportOptions{}, now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC), from)
+	require.Equal(t, now, to)
+
+	from, to, err = usageReportPeriod(usageReportOptions{From: "2021-01-01", To: "2021-02-01"}, now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), from)
+	require.Equal(t, time.Date(2021, 2, 1, 0, 0, 0, 0, time.UTC), to)
+
+	_, _, err = usageReportPeriod(usageReportOptions{From: "2021-02-01", To: "2021-01-01"}, now)
+	require.EqualError(t, err, "from time should be before to time")
+}
+
+func TestFilterAndSortInstanceTypes(t *testing.T) {
+	items := []sdk.InstanceType{
+		{InstanceType: "m5.xlarge", Vcpu: 4, Ram: 16384, Price: "0.23"},
+		{InstanceType: "t3a.large", Vcpu: 2, Ram: 8192, Price: "0.0864"},
+		{InstanceType: "c5.xlarge", Vcpu: 4, Ram: 8192, Price: "0.194"},
+		{InstanceType: "x1.unknown", Vcpu: 64, Ram: 1024000, Price: ""},
+	}
+	names := func(items []sdk.InstanceType) []string {
+		var res []string
+		for _, item := range items {
+			res = append(res, item.InstanceType)
+		}
+		return res
+	}
+
+	res := filterInstanceTypes(items, instanceTypesListOptions{MinCPU: 4})
+	sortInstanceTypes(res, "price")
+	require.Equal(t, []string{"c5.xlarge", "m5.xlarge", "x1.unknown"}, names(res))
+
+	res = filterInstanceTypes(items, instanceTypesListOptions{MinRAM: 16, MaxPrice: 1})
+	require.Equal(t, []string{"m5.xlarge"}, names(res))
+
+	sortInstanceTypes(items, "ram")
+	require.Equal(t, []string{"c5.xlarge", "t3a.large", "m5.xlarge", "x1.unknown"}, names(items))
+}
+
+func TestValidateInstanceType(t *testing.T) {
+	api := client.NewMock()
+
+	require.NoError(t, validateInstanceType(context.Background(), api, "aws", "m5.large"))
+	require.EqualError(t, validateInstanceType(context.Background(), api, "gcp", "m5.large"),
+		`instance type "m5.large" not found in gcp cloud, run 'cast instance-types list --cloud=gcp' to see available types`)
+}
+
+func TestParseNode(t *testing.T) {
+	price := "0.05"
+	tests := []struct {
+		in      string
+		want    sdk.Node
+		wantErr string
+	}{
+		{in: "aws-master-medium", want: sdk.Node{Cloud: "aws", Role: "master", Shape: "medium"}},
+		{in: "gcp-worker-large:spot", want: sdk.Node{Cloud: "gcp", Role: "worker", Shape: "large", SpotConfig: &sdk.NodeSpotConfig{IsSpot: true}}},
+		{in: "aws-worker-small:spot=0.05", want: sdk.Node{Cloud: "aws", Role: "worker", Shape: "small", SpotConfig: &sdk.NodeSpotConfig{IsSpot: true, Price: &price}}},
+		{in: "aws-worker", wantErr: `unknown node format "aws-worker", it should contain cloud, type and shape or instance type, eg. --node=aws-master-medium, --node=aws-worker-small:spot or --node=aws-worker@m5.xlarge`},
+		{in: "aws-worker@m5.xlarge", want: sdk.Node{Cloud: "aws", Role: "worker", InstanceType: "m5.xlarge"}},
+		{in: "gcp-worker-large@e2-standard-4:spot", want: sdk.Node{Cloud: "gcp", Role: "worker", Shape: "large", InstanceType: "e2-standard-4", SpotConfig: &sdk.NodeSpotConfig{IsSpot: true}}},
+		{in: "aws-worker-small@:spot", wantErr: `empty node instance type in "aws-worker-small@:spot", eg. --node=aws-worker@m5.xlarge`},
+		{in: "aws-worker-small:preemptible", wantErr: `unknown node lifecycle "preemptible", allowed values: spot, spot=<max_price>`},
+		{in: "gcp-worker-small:spot=0.05", wantErr: `spot max price is supported only on aws, got cloud "gcp"`},
+		{in: "aws-worker-small:spot=-1", wantErr: `invalid spot max price "-1", it should be positive hourly price, eg. 0.05`},
+	}
+	for _, test := range tests {
+		t.Run(test.in, func(t *testing.T) {
+			node, err := parseNode(test.in)
+			if test.wantErr != "" {
+				require.EqualError(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.want, node)
+			require.Equal(t, test.in, nodeSpecString(node))
+		})
+	}
+}
+
+func TestNodeSpecString(t *testing.T) {
+	price := "0.05"
+	require.Equal(t, "aws-master@t3a.large", nodeSpecString(sdk.Node{Cloud: "aws", Role: "master", Shape: "t3a.large", InstanceType: "t3a.large"}))
+	require.Equal(t, "aws-master@t3a.large", nodeSpecString(sdk.Node{Cloud: "aws", Role: "master", Shape: "t3a.large"}))
+	require.Equal(t, "aws-worker-large@m5.xlarge:spot=0.05", nodeSpecString(sdk.Node{Cloud: "aws", Role: "worker", Shape: "large", InstanceType: "m5.xlarge", SpotConfig: &sdk.NodeSpotConfig{IsSpot: true, Price: &price}}))
+}
+
+func TestToClusterSpec(t *testing.T) {
+	cluster := &sdk.KubernetesCluster{Name: "test", Region: sdk.ClusterRegion{Name: "eu-central"}}
+	worker, master, bad := "worker", "master", "bad"
+	nodes := []sdk.Node{
+		{Name: &worker, Cloud: "aws", Role: "worker", Shape: "large", InstanceType: "m5.xlarge"},
+		{Name: &master, Cloud: "aws", Role: "master", Shape: "t3a.large", InstanceType: "t3a.large"},
+	}
+
+	spec, err := toClusterSpec(logrus.New(), cluster, nodes, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"aws-master@t3a.large", "aws-worker-large@m5.xlarge"}, spec.Nodes)
+	require.Equal(t, "worker", nodeName(nodes[0]))
+
+	_, err = toClusterSpec(logrus.New(), cluster, []sdk.Node{{Name: &bad, Cloud: "vultr", Role: "worker", Shape: "small"}}, nil)
+	require.EqualError(t, err, `node bad can't be expressed in cluster spec: unknown node cloud "vultr", allowed values: aws, gcp, azure, do`)
+}
+
+func TestParseNodeSelector(t *testing.T) {
+	sel, err := parseNodeSelector("cloud=aws, role=worker,lifecycle=spot")
+	require.NoError(t, err)
+	require.Equal(t, nodeSelector{"cloud": "aws", "role": "worker", "lifecycle": "spot"}, sel)
+
+	str := func(v string) *string { return &v }
+	require.True(t, sel.matches(sdk.Node{Cloud: "aws", Role: "worker", SpotConfig: &sdk.NodeSpotConfig{IsSpot: true}}))
+	require.False(t, sel.matches(sdk.Node{Cloud: "aws", Role: "worker"}))
+	require.False(t, sel.matches(sdk.Node{Cloud: "aws", Role: "worker", SpotConfig: &sdk.NodeSpotConfig{IsSpot: true}, State: &sdk.NodeState{Phase: str("deleting")}}))
+
+	_, err = parseNodeSelector("cloud")
+	require.EqualError(t, err, `invalid node selector "cloud", expected key=value pairs, eg. cloud=aws,role=worker`)
+	_, err = parseNodeSelector("zone=a")
+	require.EqualError(t, err, `unknown node selector key "zone", allowed values: cloud,role,shape,instance-type,lifecycle,phase`)
+}
+
+func TestToNodeScaleRequest(t *testing.T) {
+	str := func(v string) *string { return &v }
+	older := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	nodes := []sdk.Node{
+		{Id: str("m1"), Cloud: "gcp", Role: "master", Shape: "large", CreatedAt: &older},
+		{Id: str("w1"), Cloud: "gcp", Role: "worker", Shape: "large", CreatedAt: &older},
+		{Id: str("w2"), Cloud: "gcp", Role: "worker", Shape: "large", CreatedAt: &newer},
+		{Id: str("w3"), Cloud: "gcp", Role: "worker", Shape: "large", CreatedAt: &newer, State: &sdk.NodeState{Phase: str("deleting")}},
+		{Id: str("s1"), Cloud: "gcp", Role: "worker", Shape: "large", CreatedAt: &newer, SpotConfig: &sdk.NodeSpotConfig{IsSpot: true}},
+		{Cloud: "gcp", Role: "worker", Shape: "large", CreatedAt: &newer},
+	}
+	spec := sdk.Node{Cloud: "gcp", Role: "worker", Shape: "large"}
+
+	req, current := toNodeScaleRequest(spec, nodes, 2)
+	require.Nil(t, req)
+	require.Equal(t, 2, current)
+
+	req, _ = toNodeScaleRequest(spec, nodes, 5)
+	require.Len(t, *req.Add, 3)
+	require.Nil(t, req.Delete)
+
+	req, _ = toNodeScaleRequest(spec, nodes, 1)
+	require.Equal(t, []sdk.DeletedNode{{Id: "w2"}}, *req.Delete)
+}
+
+func TestHandleClusterApplyWaitExistingCluster(t *testing.T) {
+	specPath := path.Join(t.TempDir(), "cluster.yaml")
+	spec := `{"apiVersion": "v1", "kind": "Cluster", "name": "test-cluster-1", "region": "eu-central", "credentials": ["aws", "gcp"], "nodes": ["aws-master-medium"], "network": {"vpn": "wireguard_full_mesh"}}`
+	require.NoError(t, ioutil.WriteFile(specPath, []byte(spec), 0600))
+
+	api := client.NewMock()
+	cmd := newClusterApplyCmd(logrus.New(), api)
+	cmd.SetOut(ioutil.Discard)
+	err := handleClusterApply(cmd, logrus.New(), api, clusterApplyOptions{File: specPath, Wait: true, Confirm: true})
+	require.EqualError(t, err, "--wait is supported only when cluster is created, cluster test-cluster-1 already exists")
+}
+
+func TestHandleNodeScaleMastersToZero(t *testing.T) {
+	api := client.NewMock()
+	cmd := newNodeScaleCmd(logrus.New(), api)
+	err := handleNodeScale(cmd, logrus.New(), api, nodeScaleOptions{Cloud: "aws", Role: "master", Shape: "medium", Count: 0})
+	require.EqualError(t, err, "master nodes can't be scaled to zero, cluster needs at least one master node")
+}
+
+func TestGetNodesDeduplicatesArgs(t *testing.T) {
+	api := client.NewMock()
+	node1, err := findNode(context.Background(), api, "00000000-0000-0000-0000-000000000000", "node1")
+	require.NoError(t, err)
+
+	var nodes []sdk.Node
+	cmd := &cobra.Command{
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			nodes, err = getNodes(cmd, api, "00000000-0000-0000-0000-000000000000", "")
+			return err
+		},
+	}
+	cmd.SetArgs([]string{"node1", *node1.Id, "node1"})
+	require.NoError(t, cmd.ExecuteContext(context.Background()))
+	require.Equal(t, []sdk.Node{*node1}, nodes)
+}
+
+func TestCheckMasterNodesRemain(t *testing.T) {
+	api := client.NewMock()
+	clusterID := "00000000-0000-0000-0000-000000000000"
+	nodes, err := api.ListClusterNodes(context.Background(), sdk.ClusterId(clusterID))
+	require.NoError(t, err)
+
+	err = checkMasterNodesRemain(context.Background(), api, clusterID, nodes)
+	require.EqualError(t, err, "selected nodes include all cluster master nodes, cluster can't be left without master nodes")
+	require.NoError(t, checkMasterNodesRemain(context.Background(), api, clusterID, nil))
+}
+
+func TestHandleDeleteNodeAllMastersByArgs(t *testing.T) {
+	api := client.NewMock()
+	res, err := api.AddClusterNode(context.Background(), "00000000-0000-0000-0000-000000000000", sdk.Node{Cloud: "aws", Role: "worker", Shape: "small"})
+	require.NoError(t, err)
+
+	cmd := newNodeDeleteCmd(logrus.New(), api)
+	require.NoError(t, cmd.ParseFlags([]string{"-c", "test-cluster-1", "--selector=", "--yes", "node1", res.NodeId}))
+	err = handleDeleteNode(cmd, logrus.New(), api)
+	require.EqualError(t, err, "selected nodes include all cluster master nodes, cluster can't be left without master nodes")
+}
+
+func TestWaitClusterWithProgress(t *testing.T) {
+	defer func(interval time.Duration) { clusterWaitPollInterval = interval }(clusterWaitPollInterval)
+	clusterWaitPollInterval = time.Millisecond
+	deleted := func(cluster *sdk.KubernetesCluster) (bool, error) {
+		return cluster == nil || cluster.Status == "deleted", nil
+	}
+
+	api := &failingClusterClient{Interface: client.NewMock(), err: &client.StatusError{Expected: http.StatusOK, Status: http.StatusNotFound}}
+	require.NoError(t, waitClusterWithProgress(context.Background(), logrus.New(), api, newClusterEventsPrinter(logrus.New(), api, "c1"), "deletion", clusterChangeWaitTimeout, deleted))
+
+	api = &failingClusterClient{Interface: client.NewMock(), err: errors.New("connection refused")}
+	err := waitClusterWithProgress(context.Background(), logrus.New(), api, newClusterEventsPrinter(logrus.New(), api, "c1"), "deletion", clusterChangeWaitTimeout, deleted)
+	require.EqualError(t, err, "getting cluster status: connection refused")
+	require.Equal(t, clusterWaitMaxErrors, api.calls)
+
+	mock := client.NewMock()
+	events := newClusterEventsPrinter(logrus.New(), mock, "00000000-0000-0000-0000-000000000000")
+	err = waitClusterWithProgress(context.Background(), logrus.New(), mock, events, "deletion", 20*time.Millisecond, deleted)
+	require.EqualError(t, err, "waiting for cluster deletion: context deadline exceeded")
+}
+
+func TestToAddExternalClusterNodeRequest(t *testing.T) {
+	req, err := toAddExternalClusterNodeRequest("aws", externalNodeAddOptions{InstanceType: "m5.large", Spot: true, SpotMaxPrice: "0.05"})
+	require.NoError(t, err)
+	price := "0.05"
+	require.Equal(t, &sdk.NodeSpotConfig{IsSpot: true, Price: &price}, req.SpotConfig)
+
+	_, err = toAddExternalClusterNodeRequest("aws", externalNodeAddOptions{InstanceType: "m5.large", Spot: true, SpotMaxPrice: "cheap"})
+	require.EqualError(t, err, `invalid spot max price "cheap", it should be positive hourly price, eg. 0.05`)
+
+	_, err = toAddExternalClusterNodeRequest("gcp", externalNodeAddOptions{InstanceType: "e2-medium", Spot: true, SpotMaxPrice: "0.05"})
+	require.EqualError(t, err, `spot max price is supported only on aws, got cloud "gcp"`)
+
+	_, err = toAddExternalClusterNodeRequest("aws", externalNodeAddOptions{InstanceType: "m5.large", SpotMaxPrice: "0.05"})
+	require.EqualError(t, err, "spot max price can be set only for spot nodes")
+}
+
+func TestCheckDetachedCredentialsUnused(t *testing.T) {
+	api := client.NewMock()
+	lists := &clusterCreationSelectLists{}
+	require.NoError(t, lists.load(context.Background(), api))
+	nodes, err := api.ListClusterNodes(context.Background(), "00000000-0000-0000-0000-000000000000")
+	require.NoError(t, err)
+
+	require.EqualError(t, checkDetachedCredentialsUnused(lists, nodes, []string{"aws"}), "cloud credentials aws can't be detached, cluster still has 1 aws nodes, delete them first")
+	require.NoError(t, checkDetachedCredentialsUnused(lists, nodes, []string{"gcp"}))
+}
+
+func TestListClustersIncludeDeleted(t *testing.T) {
+	newRoot := func() *cobra.Command {
+		return NewRootCmd(logrus.New(), &config.Config{}, &deletedClusterClient{client.NewMock()}, &mockTerminal{}, &mockIpify{})
+	}
+
+	// Flag value is kept in package variable, so it's always passed explicitly.
+	out, err := executeCommand(newRoot(), "cluster", "list", "--include-deleted=false")
+	require.NoError(t, err)
+	require.Contains(t, out, "00000000-0000-0000-0000-000000000000")
+	require.NotContains(t, out, "deleted-cluster")
+
+	out, err = executeCommand(newRoot(), "cluster", "list", "--include-deleted=true")
+	require.NoError(t, err)
+	require.Contains(t, out, "00000000-0000-0000-0000-000000000000")
+	require.Contains(t, out, "deleted-cluster")
+}
+
+func TestGetDeletedCluster(t *testing.T) {
+	ctx := context.Background()
+	cluster, err := getDeletedCluster(ctx, &deletedClusterClient{client.NewMock()}, "test-cluster-1")
+	require.NoError(t, err)
+	require.Equal(t, "deleted-cluster", cluster.Id)
+
+	_, err = getDeletedCluster(ctx, client.NewMock(), "test-cluster-1")
+	require.EqualError(t, err, "deleted cluster test-cluster-1 not found")
+
+	_, err = getDeletedCluster(ctx, client.NewMock(), "00000000-0000-0000-0000-000000000000")
+	require.EqualError(t, err, "cluster test-cluster-1 status is ready, only deleted clusters can be archived")
+}
+
+func TestWaitNodeReplacedWithProgress(t *testing.T) {
+	defer func(interval time.Duration) { clusterWaitPollInterval = interval }(clusterWaitPollInterval)
+	clusterWaitPollInterval = time.Millisecond
+	clusterID := "00000000-0000-0000-0000-000000000000"
+	name := "interrupted"
+	interrupted := sdk.Node{Name: &name, Cloud: "aws", Role: "master"}
+
+	api := &flakyNodesClient{Interface: client.NewMock(), failures: 2}
+	node, err := waitNodeReplacedWithProgress(context.Background(), logrus.New(), api, newClusterEventsPrinter(logrus.New(), api, clusterID), interrupted, nil)
+	require.NoError(t, err)
+	require.Equal(t, "node1", nodeName(*node))
+
+	api = &flakyNodesClient{Interface: client.NewMock(), failures: clusterWaitMaxErrors}
+	_, err = waitNodeReplacedWithProgress(context.Background(), logrus.New(), api, newClusterEventsPrinter(logrus.New(), api, clusterID), interrupted, nil)
+	require.EqualError(t, err, "listing cluster nodes: connection refused")
+}
+
+func TestClusterEventsPrinterSkipExisting(t *testing.T) {
+	buf := new(bytes.Buffer)
+	log := logrus.New()
+	log.SetOutput(buf)
+	api := &feedbackEventsClient{Interface: client.NewMock()}
+	api.add("old event")
+
+	events := newClusterEventsPrinter(log, api, "00000000-0000-0000-0000-000000000000")
+	events.skipExisting(context.Background())
+	api.add("new event")
+	events.print(context.Background())
+
+	require.NotContains(t, buf.String(), "old event")
+	require.Contains(t, buf.String(), "new event")
+}
+
+func TestToDeletedNodes(t *testing.T) {
+	str := func(v string) *string { return &v }
+	nodes := []sdk.Node{{Id: str("w1")}, {Id: str("w2")}}
+
+	require.Equal(t, []sdk.DeletedNode{{Id: "w1"}, {Id: "w2"}}, toDeletedNodes(nodes, nil))
+
+	force := false
+	timeout := 600
+	deleted := toDeletedNodes(nodes, &nodeDrainOptions{Timeout: 10 * time.Minute, Force: &force})
+	require.Equal(t, sdk.DeletedNode{Id: "w2", DrainTimeout: &timeout, Force: &force}, deleted[1])
+
+	deleted = toDeletedNodes(nodes, &nodeDrainOptions{Force: &force})
+	require.Nil(t, deleted[0].DrainTimeout)
+}
+
+func TestNodeDrainString(t *testing.T) {
+	timeout := 600
+	force := true
+	require.Equal(t, "drain_timeout=10m0s force=true", nodeDrainString(&timeout, &force))
+	require.Equal(t, "drain_timeout=default force=default", nodeDrainString(nil, nil))
+}
+
+func TestNodeInterruptCandidates(t *testing.T) {
+	str := func(v string) *string { return &v }
+	ready := &sdk.NodeState{Phase: str("ready")}
+	nodes := []sdk.Node{
+		{Id: str("m1"), Role: "master", State: ready},
+		{Id: str("w1"), Role: "worker", State: ready},
+		{Id: str("w2"), Role: "worker", State: ready, SpotConfig: &sdk.NodeSpotConfig{IsSpot: true}},
+		{Id: str("w3"), Role: "worker", State: &sdk.NodeState{Phase: str("creating")}, SpotConfig: &sdk.NodeSpotConfig{IsSpot: true}},
+	}
+
+	require.Len(t, nodeInterruptCandidates(nodes, "", false), 3)
+	require.Len(t, nodeInterruptCandidates(nodes, "worker", false), 2)
+	candidates := nodeInterruptCandidates(nodes, "worker", true)
+	require.Len(t, candidates, 1)
+	require.Equal(t, "w2", *candidates[0].Id)
+
+	// Random node is picked from workers by default.
+	cmd := newNodeInterruptCmd(logrus.New(), client.NewMock())
+	require.Equal(t, "worker", cmd.PersistentFlags().Lookup("role").DefValue)
+}
+
+func TestBuildClusterUpdatePlan(t *testing.T) {
+	api := client.NewMock()
+	lists := &clusterCreationSelectLists{}
+	require.NoError(t, lists.load(context.Background(), api))
+	cluster, err := api.GetCluster(context.Background(), "00000000-0000-0000-0000-000000000000")
+	require.NoError(t, err)
+
+	_, err = buildClusterUpdatePlan(lists, cluster, clusterUpdateOptions{AttachCredentials: []string{"aws"}})
+	require.EqualError(t, err, "cloud credentials aws are already attached to cluster")
+
+	_, err = buildClusterUpdatePlan(lists, cluster, clusterUpdateOptions{DetachCredentials: []string{"aws"}})
+	require.EqualError(t, err, "cluster should have at least one cloud credentials")
+
+	_, err = buildClusterUpdatePlan(lists, cluster, clusterUpdateOptions{AttachCredentials: []string{"gcp"}})
+	require.EqualError(t, err, "vpn value '' is not valid, available values: wireguard_cross_location_mesh, wireguard_full_mesh, cloud_provider")
+
+	_, err = buildClusterUpdatePlan(lists, cluster, clusterUpdateOptions{AWSVPCCidr: "10.0.0.0"})
+	require.Error(t, err)
+
+	plan, err := buildClusterUpdatePlan(lists, cluster, clusterUpdateOptions{AttachCredentials: []string{"gcp"}, VPN: "wireguard_full_mesh"})
+	require.NoError(t, err)
+	require.Len(t, plan.Update.CloudCredentialsIDs, 2)
+	require.Equal(t, "fullMesh", plan.Update.Network.Vpn.WireGuard.Topology)
+}
+
+func TestFilterAuditLogEvents(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2021, 1, d, 0, 0, 0, 0, time.UTC) }
+	events := []sdk.AuditLogEvent{
+		{Id: "3", CreatedAt: day(3)},
+		{Id: "1", CreatedAt: day(1)},
+		{Id: "2", CreatedAt: day(2)},
+	}
+
+	res := filterAuditLogEvents(events, time.Time{})
+	require.Equal(t, []string{"1", "2", "3"}, []string{res[0].Id, res[1].Id, res[2].Id})
+
+	res = filterAuditLogEvents(events, day(2))
+	require.Len(t, res, 2)
+	require.Equal(t, "2", res[0].Id)
+}
+
+func TestFormatAuditLogMetadata(t *testing.T) {
+	metadata := map[string]interface{}{
+		"name":    "my cluster",
+		"count":   float64(3),
+		"deleted": false,
+		"empty":   nil,
+		"node":    map[string]interface{}{"cloud": "aws", "ips": []interface{}{"10.0.0.1", "10.0.0.2"}},
+	}
+	require.Equal(t, `count=3 deleted=false empty="" name="my cluster" node.cloud=aws node.ips.0=10.0.0.1 node.ips.1=10.0.0.2`, formatAuditLogMetadata(metadata))
+	require.Equal(t, "", formatAuditLogMetadata(nil))
+}
+
+type repeatingCursorClient struct {
+	client.Interface
+}
+
+func (c *repeatingCursorClient) ListAuditEvents(ctx context.Context, req *sdk.ListAuditEventsParams) (*sdk.AuditEventList, error) {
+	return &sdk.AuditEventList{NextCursor: "c1"}, nil
+}
+
+func TestListAllAuditEventsRepeatedCursor(t *testing.T) {
+	_, err := listAllAuditEvents(context.Background(), &repeatingCursorClient{client.NewMock()}, &sdk.ListAuditEventsParams{})
+	require.EqualError(t, err, "audit events pagination cursor c1 repeated")
+}
+
+// failingClusterClient fails every cluster get with given error.
+type failingClusterClient struct {
+	client.Interface
+	err   error
+	calls int
+}
+
+func (c *failingClusterClient) GetCluster(ctx context.Context, clusterID sdk.ClusterId) (*sdk.KubernetesCluster, error) {
+	c.calls++
+	return nil, c.err
+}
+
+// flakyNodesClient fails given number of first cluster nodes list calls.
+type flakyNodesClient struct {
+	client.Interface
+	failures int
+}
+
+func (c *flakyNodesClient) ListClusterNodes(ctx context.Context, clusterID sdk.ClusterId) ([]sdk.Node, error) {
+	if c.failures > 0 {
+		c.failures--
+		return nil, errors.New("connection refused")
+	}
+	return c.Interface.ListClusterNodes(ctx, clusterID)
+}
+
+// deletedClusterClient lists deleted cluster with the same name before the live one.
+type deletedClusterClient struct {
+	client.Interface
+}
+
+func (c *deletedClusterClient) ListKubernetesClusters(ctx context.Context, req *sdk.ListKubernetesClustersParams) ([]sdk.KubernetesCluster, error) {
+	items, err := c.Interface.ListKubernetesClusters(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	createdAt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	deleted := sdk.KubernetesCluster{Id: "deleted-cluster", Name: "test-cluster-1", Status: "deleted", Region: sdk.ClusterRegion{Name: "us-east"}, CreatedAt: &createdAt}
+	return append([]sdk.KubernetesCluster{deleted}, items...), nil
+}
+
+// feedbackEventsClient returns added cluster feedback events.
+type feedbackEventsClient struct {
+	client.Interface
+	events []sdk.KubernetesClusterFeedbackEvent
+}
+
+func (c *feedbackEventsClient) add(message string) {
+	c.events = append(c.events, sdk.KubernetesClusterFeedbackEvent{
+		Id:        fmt.Sprintf("e%d", len(c.events)),
+		CreatedAt: time.Now(),
+		Message:   message,
+		Severity:  "info",
+	})
+}
+
+func (c *feedbackEventsClient) GetClusterFeedbackEvents(ctx context.Context, clusterID sdk.ClusterId) ([]sdk.KubernetesClusterFeedbackEvent, error) {
+	return c.events, nil
+}
+
+// inUseCredentialsClient adds credentials used by a cluster on top of the mock client credentials.
+type inUseCredentialsClient struct {
+	client.Interface
+	credentials *sdk.CloudCredentials
+}
+
+func (c *inUseCredentialsClient) ListCloudCredentials(ctx context.Context) ([]sdk.CloudCredentials, error) {
+	items, err := c.Interface.ListCloudCredentials(ctx)
+	if err != nil || c.credentials == nil {
+		return items, err
+	}
+	return append(items, *c.credentials), nil
+}
+
+func (c *inUseCredentialsClient) GetCloudCredentials(ctx context.Context, credentialsID sdk.CredentialsId) (*sdk.CloudCredentials, error) {
+	if c.credentials != nil && c.credentials.Id == string(credentialsID) {
+		return c.credentials, nil
+	}
+	return c.Interface.GetCloudCredentials(ctx, credentialsID)
+}
+
+func (c *inUseCredentialsClient) DeleteCloudCredentials(ctx context.Context, credentialsID sdk.CredentialsId) error {
+	if c.credentials != nil && c.credentials.Id == string(credentialsID) {
+		c.credentials = nil
+		return nil
+	}
+	return c.Interface.DeleteCloudCredentials(ctx, credentialsID)
+}
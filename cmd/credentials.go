@@ -16,7 +16,19 @@ limitations under the License.
 
 package cmd
 
-import "github.com/spf13/cobra"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
 
 func newCredentialsCmd() *cobra.Command {
 	return &cobra.Command{
@@ -24,3 +36,69 @@ func newCredentialsCmd() *cobra.Command {
 		Short: "Manage credentials",
 	}
 }
+
+// getCredentialsFromArgs gets cloud credentials by name or ID passed as first argument or shows interactive selection list.
+func getCredentialsFromArgs(cmd *cobra.Command, api client.Interface) (*sdk.CloudCredentials, error) {
+	ctx := cmd.Context()
+	if len(cmd.Flags().Args()) == 0 {
+		return selectCredentials(ctx, api)
+	}
+
+	return getCredentials(ctx, api, cmd.Flags().Args()[0])
+}
+
+// getCredentials gets cloud credentials from api by name or ID.
+func getCredentials(ctx context.Context, api client.Interface, credentialsNameOrID string) (*sdk.CloudCredentials, error) {
+	uuidID, err := uuid.Parse(credentialsNameOrID)
+	if err == nil {
+		return api.GetCloudCredentials(ctx, sdk.CredentialsId(uuidID.String()))
+	}
+
+	items, err := api.ListCloudCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if strings.EqualFold(item.Name, credentialsNameOrID) {
+			return &item, nil
+		}
+	}
+	return nil, fmt.Errorf("credentials not found, id=%s", credentialsNameOrID)
+}
+
+// selectCredentials shows interactive cloud credentials selection list and returns selected credentials.
+func selectCredentials(ctx context.Context, api client.Interface) (*sdk.CloudCredentials, error) {
+	items, err := api.ListCloudCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.New("no credentials found")
+	}
+	displayName := func(item sdk.CloudCredentials) string {
+		return fmt.Sprintf("(%s) %s", item.Cloud, item.Name)
+	}
+	selectList := make([]string, len(items))
+	for i, item := range items {
+		selectList[i] = displayName(item)
+	}
+
+	var selected string
+	prompt := &survey.Select{
+		Message: "Select credentials:",
+		Options: selectList,
+		Default: selectList[0],
+	}
+
+	if err := survey.AskOne(prompt, &selected, survey.WithValidator(survey.Required)); err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if displayName(item) == selected {
+			return &item, nil
+		}
+	}
+
+	return nil, errors.New("credentials not found")
+}
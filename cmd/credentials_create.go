@@ -0,0 +1,176 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+)
+
+type credentialsCreateOptions struct {
+	Name     string
+	Cloud    string
+	FromFile string
+	Token    string
+}
+
+func newCredentialsCreateCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := credentialsCreateOptions{}
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create cloud credentials",
+		Long: `
+Credentials file format depends on the cloud:
+  aws   - JSON with accessKeyId and secretAccessKey fields
+  gcp   - service account JSON key file
+  azure - JSON with subscriptionId, tenantId, clientId and clientSecret fields
+  do    - JSON with token field or plain access token, access token can also be passed with --token flag
+
+Examples:
+  # Create AWS credentials.
+  cast credentials create --cloud=aws --name=aws-prod --from-file=aws.json
+
+  # Create GCP credentials from service account key.
+  cast credentials create --cloud=gcp --name=gcp-prod --from-file=service-account.json
+
+  # Create DigitalOcean credentials reading access token from stdin.
+  echo $DO_TOKEN | cast credentials create --cloud=do --name=do-prod --from-file=-
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleCredentialsCreate(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVar(&opts.Name, "name", "", "credentials name")
+	cmd.PersistentFlags().StringVar(&opts.Cloud, "cloud", "", fmt.Sprintf("credentials cloud, possible values: %s", strings.Join(supportedClouds, ",")))
+	cmd.PersistentFlags().StringVar(&opts.FromFile, "from-file", "", "path to credentials file, use - to read from stdin")
+	cmd.PersistentFlags().StringVar(&opts.Token, "token", "", "DigitalOcean access token, can be used only with --cloud=do")
+	cmd.MarkPersistentFlagRequired("name")
+	cmd.MarkPersistentFlagRequired("cloud")
+	command.AddJSONOutput(cmd)
+	return cmd
+}
+
+func handleCredentialsCreate(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts credentialsCreateOptions) error {
+	if opts.Token != "" {
+		if opts.Cloud != "do" {
+			usagef(cmd, "--token can be used only with --cloud=do, got cloud %q", opts.Cloud)
+		}
+		if opts.FromFile != "" {
+			usagef(cmd, "--token and --from-file can't be used together")
+		}
+	}
+
+	var data []byte
+	switch {
+	case opts.Token != "":
+		data = []byte(opts.Token)
+	case opts.FromFile == "-":
+		b, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("reading credentials from stdin: %w", err)
+		}
+		data = b
+	case opts.FromFile != "":
+		b, err := ioutil.ReadFile(opts.FromFile)
+		if err != nil {
+			return fmt.Errorf("reading credentials file: %w", err)
+		}
+		data = b
+	default:
+		usagef(cmd, "credentials file or token is required, eg. --from-file=creds.json")
+	}
+
+	credentials, err := toCredentialsData(opts.Cloud, data)
+	if err != nil {
+		return err
+	}
+
+	res, err := api.CreateCloudCredentials(cmd.Context(), sdk.CreateCloudCredentialsJSONRequestBody{
+		Cloud:       opts.Cloud,
+		Name:        opts.Name,
+		Credentials: credentials,
+	})
+	if err != nil {
+		return err
+	}
+
+	if command.OutputJSON() {
+		command.PrintOutput(res)
+		return nil
+	}
+
+	log.Infof("Credentials %s created, id=%s", res.Name, res.Id)
+	return nil
+}
+
+// toCredentialsData validates credentials data for given cloud and converts it to API credentials format.
+func toCredentialsData(cloud string, data []byte) (string, error) {
+	data = []byte(strings.TrimSpace(string(data)))
+	if len(data) == 0 {
+		return "", errors.New("credentials are empty")
+	}
+
+	var requiredFields []string
+	switch cloud {
+	case "aws":
+		requiredFields = []string{"accessKeyId", "secretAccessKey"}
+	case "gcp":
+		requiredFields = []string{"type", "project_id", "private_key", "client_email"}
+	case "azure":
+		requiredFields = []string{"subscriptionId", "tenantId", "clientId", "clientSecret"}
+	case "do":
+		// DigitalOcean access token can be passed as is.
+		if !json.Valid(data) {
+			b, err := json.Marshal(map[string]string{"token": string(data)})
+			if err != nil {
+				return "", err
+			}
+			data = b
+		}
+		requiredFields = []string{"token"}
+	default:
+		return "", fmt.Errorf("unknown credentials cloud %q, allowed values: %s", cloud, strings.Join(supportedClouds, ", "))
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", fmt.Errorf("%s credentials should be valid JSON object: %w", cloud, err)
+	}
+	for _, field := range requiredFields {
+		if v, ok := fields[field].(string); !ok || v == "" {
+			return "", fmt.Errorf("%s credentials field %q is required", cloud, field)
+		}
+	}
+	if cloud == "gcp" && fields["type"] != "service_account" {
+		return "", fmt.Errorf("gcp credentials should be service account key, got type %q", fields["type"])
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+type credentialsDeleteOptions struct {
+	Confirm bool
+	Force   bool
+}
+
+func newCredentialsDeleteCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := credentialsDeleteOptions{}
+	cmd := &cobra.Command{
+		Use:   "delete <credentials_name_or_id>",
+		Short: "Delete cloud credentials",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleCredentialsDelete(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().BoolVarP(&opts.Confirm, "yes", "y", false, "confirm credentials deletion")
+	cmd.PersistentFlags().BoolVar(&opts.Force, "force", false, "delete credentials even if they are used by clusters")
+	return cmd
+}
+
+func handleCredentialsDelete(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts credentialsDeleteOptions) error {
+	credentials, err := getCredentialsFromArgs(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	if !opts.Force {
+		if err := checkCredentialsNotUsed(*credentials); err != nil {
+			return err
+		}
+	}
+
+	if !opts.Confirm {
+		if err := survey.AskOne(&survey.Confirm{
+			Message: "Are you sure?",
+		}, &opts.Confirm); err != nil {
+			return err
+		}
+	}
+
+	if !opts.Confirm {
+		log.Info("Credentials delete canceled")
+		return nil
+	}
+
+	if err := api.DeleteCloudCredentials(cmd.Context(), sdk.CredentialsId(credentials.Id)); err != nil {
+		return err
+	}
+
+	log.Infof("Credentials %s deleted", credentials.Name)
+	return nil
+}
+
+func checkCredentialsNotUsed(credentials sdk.CloudCredentials) error {
+	if getCredentialsUsedByCount(credentials) == 0 {
+		return nil
+	}
+	names := make([]string, 0, len(*credentials.UsedBy))
+	for _, item := range *credentials.UsedBy {
+		names = append(names, item.Name)
+	}
+	return fmt.Errorf("credentials %s are used by clusters: %s, pass --force to delete anyway", credentials.Name, strings.Join(names, ", "))
+}
@@ -0,0 +1,150 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/google/uuid"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+)
+
+const (
+	externalProviderEKS = "eks"
+	externalProviderGKE = "gke"
+)
+
+func newExternalCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "external",
+		Short: "Manage external (EKS, GKE) clusters",
+	}
+}
+
+// getExternalClusterFromFlag gets external cluster by name or ID from --cluster flag or shows interactive selection list.
+func getExternalClusterFromFlag(cmd *cobra.Command, api client.Interface) (*sdk.ExternalCluster, error) {
+	value, err := cmd.Flags().GetString(flagCluster)
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return selectExternalCluster(cmd.Context(), api)
+	}
+	return getExternalCluster(cmd.Context(), api, value)
+}
+
+// getExternalClusterFromArgs gets external cluster by name or ID passed as first argument or shows interactive selection list.
+func getExternalClusterFromArgs(cmd *cobra.Command, api client.Interface) (*sdk.ExternalCluster, error) {
+	ctx := cmd.Context()
+	if len(cmd.Flags().Args()) == 0 {
+		return selectExternalCluster(ctx, api)
+	}
+	return getExternalCluster(ctx, api, cmd.Flags().Args()[0])
+}
+
+// getExternalCluster gets external cluster from api by name or ID.
+func getExternalCluster(ctx context.Context, api client.Interface, clusterNameOrID string) (*sdk.ExternalCluster, error) {
+	uuidID, err := uuid.Parse(clusterNameOrID)
+	if err == nil {
+		return api.GetExternalCluster(ctx, uuidID.String())
+	}
+
+	items, err := api.ListExternalClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if strings.EqualFold(item.Name, clusterNameOrID) {
+			return &item, nil
+		}
+	}
+	return nil, fmt.Errorf("external cluster not found, id=%s", clusterNameOrID)
+}
+
+// selectExternalCluster shows interactive external cluster selection list and returns selected cluster.
+func selectExternalCluster(ctx context.Context, api client.Interface) (*sdk.ExternalCluster, error) {
+	items, err := api.ListExternalClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.New("no external clusters found")
+	}
+	selectList := make([]string, len(items))
+	for i, item := range items {
+		selectList[i] = item.Name
+	}
+
+	var selected string
+	prompt := &survey.Select{
+		Message: "Select external cluster:",
+		Options: selectList,
+		Default: selectList[0],
+	}
+
+	if err := survey.AskOne(prompt, &selected, survey.WithValidator(survey.Required)); err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item.Name == selected {
+			return &item, nil
+		}
+	}
+
+	return nil, errors.New("external cluster not found")
+}
+
+func printExternalClustersTable(out io.Writer, items []sdk.ExternalCluster) {
+	t := table.NewWriter()
+	t.SetStyle(command.DefaultTableStyle)
+	t.SetOutputMirror(out)
+	t.AppendHeader(table.Row{"ID", "Name", "Status", "Provider", "Cluster_Name", "Region"})
+	for _, item := range items {
+		provider, clusterName, region := externalClusterProvider(item)
+		t.AppendRow(table.Row{
+			item.Id,
+			item.Name,
+			nodeValueString(item.Status),
+			provider,
+			clusterName,
+			region,
+		})
+	}
+	t.Render()
+}
+
+// externalClusterProvider returns provider, cluster name and region from EKS or GKE configuration.
+func externalClusterProvider(item sdk.ExternalCluster) (provider, clusterName, region string) {
+	switch {
+	case item.Eks != nil:
+		return externalProviderEKS, nodeValueString(item.Eks.ClusterName), nodeValueString(item.Eks.Region)
+	case item.Gke != nil:
+		return externalProviderGKE, nodeValueString(item.Gke.ClusterName), nodeValueString(item.Gke.Region)
+	}
+	return "", "", ""
+}
@@ -0,0 +1,123 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/google/uuid"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+)
+
+func newExternalNodeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "node",
+		Short: "Manage external cluster nodes",
+	}
+}
+
+// getExternalNode gets external cluster node by name or ID passed as first argument or shows interactive selection list.
+func getExternalNode(cmd *cobra.Command, api client.Interface, clusterID string) (*sdk.ExternalClusterNode, error) {
+	ctx := cmd.Context()
+	if len(cmd.Flags().Args()) == 0 {
+		return selectExternalNode(ctx, api, clusterID)
+	}
+
+	value := cmd.Flags().Args()[0]
+	nodes, err := api.ListExternalClusterNodes(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	_, uuidErr := uuid.Parse(value)
+	for _, node := range nodes {
+		if (uuidErr == nil && node.Id == value) || strings.EqualFold(node.Name, value) {
+			return &node, nil
+		}
+	}
+	return nil, fmt.Errorf("node not found, searched by value=%s", value)
+}
+
+func selectExternalNode(ctx context.Context, api client.Interface, clusterID string) (*sdk.ExternalClusterNode, error) {
+	displayName := func(item sdk.ExternalClusterNode) string {
+		return fmt.Sprintf("%-45s %s %s %s %s", item.Name, item.Cloud, item.InstanceType, item.Zone, spotStatus(item.SpotConfig.IsSpot))
+	}
+	items, err := api.ListExternalClusterNodes(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.New("no nodes found")
+	}
+	selectList := make([]string, len(items))
+	for i, item := range items {
+		selectList[i] = displayName(item)
+	}
+
+	var selected string
+	prompt := &survey.Select{
+		Message: "Select node:",
+		Options: selectList,
+		Default: selectList[0],
+	}
+
+	if err := survey.AskOne(prompt, &selected, survey.WithValidator(survey.Required)); err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if displayName(item) == selected {
+			return &item, nil
+		}
+	}
+
+	return nil, errors.New("external cluster node not found")
+}
+
+func printExternalNodesTable(out io.Writer, items []sdk.ExternalClusterNode) {
+	t := table.NewWriter()
+	t.SetStyle(command.DefaultTableStyle)
+	t.SetOutputMirror(out)
+	t.AppendHeader(table.Row{"ID", "Name", "Cloud", "Instance_Type", "Zone", "Lifecycle"})
+	for _, item := range items {
+		t.AppendRow(table.Row{
+			item.Id,
+			item.Name,
+			item.Cloud,
+			item.InstanceType,
+			item.Zone,
+			spotStatus(item.SpotConfig.IsSpot),
+		})
+	}
+	t.Render()
+}
+
+func spotStatus(isSpot bool) string {
+	if isSpot {
+		return "spot"
+	}
+	return "on-demand"
+}
@@ -0,0 +1,143 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+type externalNodeAddOptions struct {
+	InstanceType string `survey:"instanceType"`
+	Zone         string `survey:"zone"`
+	Spot         bool   `survey:"spot"`
+	SpotMaxPrice string
+	Wait         bool
+}
+
+func newExternalNodeAddCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := externalNodeAddOptions{}
+	cmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add external cluster node",
+		Long: `
+Instance type, zone and spot options are asked interactively if --instance-type is not passed.
+
+Examples:
+  # Add spot node and wait until it's created.
+  cast external node add -c eks-prod --instance-type=m5.large --zone=eu-central-1a --spot --wait
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleExternalNodeAdd(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringP(flagCluster, "c", "", "external cluster name or ID")
+	cmd.PersistentFlags().StringVar(&opts.InstanceType, "instance-type", "", "node instance type, eg. --instance-type=m5.large")
+	cmd.PersistentFlags().StringVar(&opts.Zone, "zone", "", "optional node zone, must be a zone of one of the cluster subnets")
+	cmd.PersistentFlags().BoolVar(&opts.Spot, "spot", false, "create spot instance")
+	cmd.PersistentFlags().StringVar(&opts.SpotMaxPrice, "spot-max-price", "", "optional max spot instance price, applicable to AWS only")
+	cmd.PersistentFlags().BoolVar(&opts.Wait, "wait", false, "wait until operation finishes, eg. --wait=true")
+	return cmd
+}
+
+func handleExternalNodeAdd(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts externalNodeAddOptions) error {
+	ctx := cmd.Context()
+	cluster, err := getExternalClusterFromFlag(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	if opts.InstanceType == "" {
+		if err := parseInteractiveExternalNodeAddForm(&opts); err != nil {
+			return err
+		}
+	}
+
+	req, err := toAddExternalClusterNodeRequest(externalClusterCloud(cluster), opts)
+	if err != nil {
+		return err
+	}
+
+	res, err := api.AddExternalClusterNode(ctx, cluster.Id, req)
+	if err != nil {
+		return err
+	}
+
+	if !opts.Wait {
+		log.Infof("External cluster node creation is now in progress, node_id=%s operation_id=%s", res.NodeId, res.OperationId)
+		return nil
+	}
+
+	log.Infof("Waiting for external cluster node creation, node_id=%s operation_id=%s", res.NodeId, res.OperationId)
+	if err := waitOperation(ctx, api.GetExternalClusterOperation, res.OperationId); err != nil {
+		return err
+	}
+	log.Info("External cluster node created")
+	return nil
+}
+
+func parseInteractiveExternalNodeAddForm(opts *externalNodeAddOptions) error {
+	qs := []*survey.Question{
+		{
+			Name:     "instanceType",
+			Prompt:   &survey.Input{Message: "Enter instance type:"},
+			Validate: survey.Required,
+		},
+		{
+			Name:   "zone",
+			Prompt: &survey.Input{Message: "Enter zone (optional):"},
+		},
+		{
+			Name:   "spot",
+			Prompt: &survey.Confirm{Message: "Use spot instance?"},
+		},
+	}
+	return survey.Ask(qs, opts)
+}
+
+func toAddExternalClusterNodeRequest(cloud string, opts externalNodeAddOptions) (sdk.AddExternalClusterNodeJSONRequestBody, error) {
+	spotConfig, err := toNodeSpotConfig(cloud, opts.Spot, opts.SpotMaxPrice)
+	if err != nil {
+		return sdk.AddExternalClusterNodeJSONRequestBody{}, err
+	}
+	req := sdk.AddExternalClusterNodeJSONRequestBody{
+		InstanceType: opts.InstanceType,
+		SpotConfig:   spotConfig,
+	}
+	if opts.Zone != "" {
+		req.Zone = &opts.Zone
+	}
+	return req, nil
+}
+
+// externalClusterCloud returns cloud name of external cluster provider.
+func externalClusterCloud(cluster *sdk.ExternalCluster) string {
+	switch {
+	case cluster.Eks != nil:
+		return "aws"
+	case cluster.Gke != nil:
+		return "gcp"
+	default:
+		return ""
+	}
+}
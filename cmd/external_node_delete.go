@@ -0,0 +1,90 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+)
+
+type externalNodeDeleteOptions struct {
+	Confirm bool
+	Wait    bool
+}
+
+func newExternalNodeDeleteCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := externalNodeDeleteOptions{}
+	cmd := &cobra.Command{
+		Use:   "delete <node_name_or_id>",
+		Short: "Delete external cluster node",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleExternalNodeDelete(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringP(flagCluster, "c", "", "external cluster name or ID")
+	cmd.PersistentFlags().BoolVarP(&opts.Confirm, "yes", "y", false, "confirm external cluster node deletion")
+	cmd.PersistentFlags().BoolVar(&opts.Wait, "wait", false, "wait until operation finishes, eg. --wait=true")
+	return cmd
+}
+
+func handleExternalNodeDelete(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts externalNodeDeleteOptions) error {
+	ctx := cmd.Context()
+	cluster, err := getExternalClusterFromFlag(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	node, err := getExternalNode(cmd, api, cluster.Id)
+	if err != nil {
+		return err
+	}
+
+	if !opts.Confirm {
+		if err := survey.AskOne(&survey.Confirm{
+			Message: "Are you sure?",
+		}, &opts.Confirm); err != nil {
+			return err
+		}
+	}
+
+	if !opts.Confirm {
+		log.Info("External cluster node delete canceled")
+		return nil
+	}
+
+	res, err := api.DeleteExternalClusterNode(ctx, cluster.Id, node.Id)
+	if err != nil {
+		return err
+	}
+
+	if !opts.Wait {
+		log.Infof("External cluster node deletion is now in progress, operation_id=%s", res.OperationId)
+		return nil
+	}
+
+	log.Infof("Waiting for external cluster node deletion, operation_id=%s", res.OperationId)
+	if err := waitOperation(ctx, api.GetExternalClusterOperation, res.OperationId); err != nil {
+		return err
+	}
+	log.Infof("External cluster node %s deleted", node.Name)
+	return nil
+}
@@ -0,0 +1,122 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+type externalRegisterOptions struct {
+	Name        string
+	Provider    string
+	ClusterName string
+	Region      string
+	Credentials string
+}
+
+func newExternalRegisterCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := externalRegisterOptions{}
+	cmd := &cobra.Command{
+		Use:   "register",
+		Short: "Register external cluster",
+		Long: `
+Registers EKS or GKE cluster and prints agent token which should be used when installing CAST AI agent.
+
+Examples:
+  # Register EKS cluster and attach AWS credentials.
+  cast external register --name=eks-prod --provider=eks --cluster-name=prod --region=eu-central-1 --credentials=aws-prod
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleExternalRegister(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVar(&opts.Name, "name", "", "cluster name in CAST AI")
+	cmd.PersistentFlags().StringVar(&opts.Provider, "provider", "", "cluster provider, available values: eks, gke")
+	cmd.PersistentFlags().StringVar(&opts.ClusterName, "cluster-name", "", "cluster name in the cloud provider, defaults to --name")
+	cmd.PersistentFlags().StringVar(&opts.Region, "region", "", "cloud provider region of the cluster, eg. --region=eu-central-1")
+	cmd.PersistentFlags().StringVar(&opts.Credentials, "credentials", "", "optional cloud credentials name or ID to attach")
+	cmd.MarkPersistentFlagRequired("name")
+	cmd.MarkPersistentFlagRequired("provider")
+	cmd.MarkPersistentFlagRequired("region")
+	return cmd
+}
+
+func handleExternalRegister(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts externalRegisterOptions) error {
+	ctx := cmd.Context()
+	req, err := toRegisterExternalClusterRequest(opts)
+	if err != nil {
+		return err
+	}
+
+	// Resolve credentials before registering cluster to fail early on invalid name.
+	var credentials *sdk.CloudCredentials
+	if opts.Credentials != "" {
+		credentials, err = getCredentials(ctx, api, opts.Credentials)
+		if err != nil {
+			return err
+		}
+	}
+
+	cluster, err := api.RegisterExternalCluster(ctx, req)
+	if err != nil {
+		return err
+	}
+	log.Infof("External cluster %s registered, id=%s", cluster.Name, cluster.Id)
+
+	if credentials != nil {
+		if _, err := api.UpdateExternalCluster(ctx, cluster.Id, sdk.UpdateExternalClusterJSONRequestBody{CredentialsId: credentials.Id}); err != nil {
+			return err
+		}
+		log.Infof("Credentials %s attached", credentials.Name)
+	}
+
+	token := nodeValueString(cluster.Token)
+	if token == "" {
+		token, err = api.GetExternalClustersToken(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	log.Info("Install CAST AI agent on the cluster using the token below")
+	fmt.Fprintln(cmd.OutOrStdout(), token)
+	return nil
+}
+
+func toRegisterExternalClusterRequest(opts externalRegisterOptions) (sdk.RegisterExternalClusterJSONRequestBody, error) {
+	clusterName := opts.ClusterName
+	if clusterName == "" {
+		clusterName = opts.Name
+	}
+	req := sdk.RegisterExternalClusterJSONRequestBody{Name: opts.Name}
+	switch opts.Provider {
+	case externalProviderEKS:
+		req.Eks = &sdk.ExternalClusterEksConfiguration{ClusterName: &clusterName, Region: &opts.Region}
+	case externalProviderGKE:
+		req.Gke = &sdk.ExternalClusterGkeConfiguration{ClusterName: &clusterName, Region: &opts.Region}
+	default:
+		return sdk.RegisterExternalClusterJSONRequestBody{}, fmt.Errorf("unknown provider %q, available values: %s, %s", opts.Provider, externalProviderEKS, externalProviderGKE)
+	}
+	return req, nil
+}
@@ -0,0 +1,61 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+func newExternalUpdateCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	var credentialsName string
+	cmd := &cobra.Command{
+		Use:   "update <cluster_name_or_id>",
+		Short: "Attach cloud credentials to external cluster",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleExternalUpdate(cmd, log, api, credentialsName); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVar(&credentialsName, "credentials", "", "cloud credentials name or ID")
+	cmd.MarkPersistentFlagRequired("credentials")
+	return cmd
+}
+
+func handleExternalUpdate(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, credentialsName string) error {
+	ctx := cmd.Context()
+	cluster, err := getExternalClusterFromArgs(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	credentials, err := getCredentials(ctx, api, credentialsName)
+	if err != nil {
+		return err
+	}
+
+	if _, err := api.UpdateExternalCluster(ctx, cluster.Id, sdk.UpdateExternalClusterJSONRequestBody{CredentialsId: credentials.Id}); err != nil {
+		return err
+	}
+
+	log.Infof("Credentials %s attached to external cluster %s", credentials.Name, cluster.Name)
+	return nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+type gslbDeleteOptions struct {
+	Namespace string
+	Service   string
+	Confirm   bool
+}
+
+func newGslbDeleteCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := gslbDeleteOptions{}
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete global DNS for LoadBalancer type service",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleGslbDelete(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringP(flagCluster, "c", "", "cluster name or ID")
+	cmd.PersistentFlags().StringVarP(&opts.Namespace, "namespace", "n", "default", "namespace of the exposed service")
+	cmd.PersistentFlags().StringVar(&opts.Service, "service", "", "name of the exposed LoadBalancer type service")
+	cmd.PersistentFlags().BoolVarP(&opts.Confirm, "yes", "y", false, "confirm gslb deletion")
+	cmd.MarkPersistentFlagRequired("service")
+	return cmd
+}
+
+func handleGslbDelete(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts gslbDeleteOptions) error {
+	cluster, err := getClusterFromFlag(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	if !opts.Confirm {
+		if err := survey.AskOne(&survey.Confirm{
+			Message: fmt.Sprintf("Delete GSLB for service %s/%s?", opts.Namespace, opts.Service),
+		}, &opts.Confirm); err != nil {
+			return err
+		}
+	}
+
+	if !opts.Confirm {
+		log.Info("GSLB delete canceled")
+		return nil
+	}
+
+	if err := api.DeleteGslb(cmd.Context(), sdk.DeleteGslbJSONRequestBody{
+		ClusterId:        cluster.Id,
+		ServiceName:      opts.Service,
+		ServiceNamespace: opts.Namespace,
+	}); err != nil {
+		return err
+	}
+	log.Infof("GSLB for service %s/%s deleted", opts.Namespace, opts.Service)
+	return nil
+}
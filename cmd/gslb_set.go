@@ -0,0 +1,103 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+)
+
+type gslbSetOptions struct {
+	Namespace string
+	Service   string
+	Hosts     []string
+}
+
+func newGslbSetCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := gslbSetOptions{}
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Create or update global DNS for LoadBalancer type service",
+		Long: `
+Globally load balances given cloud load balancer hosts. If hosts are not passed, all cluster
+ingress controller load balancer addresses are used, see 'cast cluster ingress'.
+
+Examples:
+  # Balance service across all cluster clouds.
+  cast gslb set -c=my-cluster --namespace=default --service=web
+
+  # Balance service across given load balancers only.
+  cast gslb set -c=my-cluster --namespace=default --service=web --host=a.example.com --host=1.2.3.4
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleGslbSet(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringP(flagCluster, "c", "", "cluster name or ID")
+	cmd.PersistentFlags().StringVarP(&opts.Namespace, "namespace", "n", "default", "namespace of the exposed service")
+	cmd.PersistentFlags().StringVar(&opts.Service, "service", "", "name of the exposed LoadBalancer type service")
+	cmd.PersistentFlags().StringSliceVar(&opts.Hosts, "host", []string{}, "cloud load balancer hostname or IP address, eg. --host=a.example.com --host=1.2.3.4")
+	cmd.MarkPersistentFlagRequired("service")
+	command.AddJSONOutput(cmd)
+	return cmd
+}
+
+func handleGslbSet(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts gslbSetOptions) error {
+	cluster, err := getClusterFromFlag(cmd, api)
+	if err != nil {
+		return err
+	}
+	ctx := cmd.Context()
+
+	hosts := opts.Hosts
+	if len(hosts) == 0 {
+		ingress, err := api.GetClusterIngressController(ctx, sdk.ClusterId(cluster.Id))
+		if err != nil {
+			return err
+		}
+		hosts = ingressLoadBalancerAddresses(ingress)
+		if len(hosts) == 0 {
+			return errors.New("cluster ingress controller has no load balancers, pass hosts manually, eg. --host=a.example.com")
+		}
+	}
+
+	res, err := api.CreateOrUpdateGslb(ctx, sdk.CreateOrUpdateGslbJSONRequestBody{
+		ClusterId:        cluster.Id,
+		Hosts:            hosts,
+		ServiceName:      opts.Service,
+		ServiceNamespace: opts.Namespace,
+	})
+	if err != nil {
+		return err
+	}
+
+	if command.OutputJSON() {
+		command.PrintOutput(res)
+		return nil
+	}
+
+	log.Infof("GSLB for service %s/%s is set, dns=%s", opts.Namespace, opts.Service, res.Dns)
+	return nil
+}
@@ -19,6 +19,9 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/cobra"
@@ -46,3 +49,33 @@ func requireClusterID(cmd *cobra.Command, args []string) uuid.UUID {
 
 	return clusterID
 }
+
+// parseTimeFlag parses time from RFC3339 timestamp (eg. 2021-01-31T15:04:05Z), date (eg. 2021-01-31)
+// or duration relative to now (eg. 30m, 24h, 7d).
+func parseTimeFlag(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	if strings.HasSuffix(value, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err == nil {
+			return time.Now().AddDate(0, 0, -days), nil
+		}
+	}
+	if d, err := time.ParseDuration(value); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q, expected RFC3339 timestamp, date (eg. 2021-01-31) or duration (eg. 24h, 7d)", value)
+}
+
+func indexOfString(values []string, v string) int {
+	for i, value := range values {
+		if value == v {
+			return i
+		}
+	}
+	return -1
+}
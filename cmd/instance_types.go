@@ -0,0 +1,85 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+func newInstanceTypesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "instance-types",
+		Short: "Browse cloud instance types catalog",
+	}
+}
+
+// instanceTypePrice returns instance type hourly price or -1 if price is unknown.
+func instanceTypePrice(item sdk.InstanceType) float64 {
+	price, err := strconv.ParseFloat(item.Price, 64)
+	if err != nil {
+		return -1
+	}
+	return price
+}
+
+// listCloudInstanceTypes returns unique cloud instance types sorted by name.
+func listCloudInstanceTypes(ctx context.Context, api client.Interface, cloud string) ([]sdk.InstanceType, error) {
+	items, err := api.ListInstanceTypes(ctx, &sdk.ListInstanceTypesParams{Providers: &[]string{cloud}})
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]struct{}, len(items))
+	res := make([]sdk.InstanceType, 0, len(items))
+	for _, item := range items {
+		if _, ok := seen[item.InstanceType]; ok {
+			continue
+		}
+		seen[item.InstanceType] = struct{}{}
+		res = append(res, item)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].InstanceType < res[j].InstanceType
+	})
+	return res, nil
+}
+
+// validateInstanceType checks that instance type exists in the cloud instance types catalog.
+func validateInstanceType(ctx context.Context, api client.Interface, cloud, instanceType string) error {
+	items, err := api.ListInstanceTypes(ctx, &sdk.ListInstanceTypesParams{
+		Providers:     &[]string{cloud},
+		InstanceTypes: &[]string{instanceType},
+	})
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("instance type %q not found in %s cloud, run 'cast instance-types list --cloud=%s' to see available types", instanceType, cloud, cloud)
+	}
+	return nil
+}
+
+func instanceTypeDisplayName(item sdk.InstanceType) string {
+	return fmt.Sprintf("%s (%d vCPU, %.1f GiB)", item.InstanceType, item.Vcpu, float64(item.Ram)/1024)
+}
@@ -0,0 +1,162 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+)
+
+var supportedInstanceTypesSort = []string{"price", "cpu", "ram", "name"}
+
+type instanceTypesListOptions struct {
+	Cloud    string
+	Region   string
+	MinCPU   int
+	MinRAM   int
+	MaxPrice float64
+	Sort     string
+}
+
+func newInstanceTypesListCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := instanceTypesListOptions{}
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List instance types",
+		Long: `
+Examples:
+  # List aws instance types with at least 4 vCPU cheaper than $0.2/hour.
+  cast instance-types list --cloud=aws --region=eu-central-1 --min-cpu=4 --max-price=0.2 --sort=price
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleInstanceTypesList(cmd, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVar(&opts.Cloud, "cloud", "", fmt.Sprintf("cloud name, possible values: %s", strings.Join(supportedClouds, ",")))
+	cmd.PersistentFlags().StringVar(&opts.Region, "region", "", "cloud provider region name, eg. --region=eu-central-1")
+	cmd.PersistentFlags().IntVar(&opts.MinCPU, "min-cpu", 0, "minimum number of vCPU")
+	cmd.PersistentFlags().IntVar(&opts.MinRAM, "min-ram", 0, "minimum memory in GiB")
+	cmd.PersistentFlags().Float64Var(&opts.MaxPrice, "max-price", 0, "maximum hourly price in $")
+	cmd.PersistentFlags().StringVar(&opts.Sort, "sort", "price", fmt.Sprintf("sort by, possible values: %s", strings.Join(supportedInstanceTypesSort, ",")))
+	command.AddJSONOutput(cmd)
+	return cmd
+}
+
+func handleInstanceTypesList(cmd *cobra.Command, api client.Interface, opts instanceTypesListOptions) error {
+	if opts.Cloud != "" && !isSupportedCloud(opts.Cloud) {
+		usagef(cmd, "unsupported cloud %q, possible values: %s", opts.Cloud, strings.Join(supportedClouds, ","))
+	}
+	if !isSupportedInstanceTypesSort(opts.Sort) {
+		usagef(cmd, "unsupported sort %q, possible values: %s", opts.Sort, strings.Join(supportedInstanceTypesSort, ","))
+	}
+
+	req := &sdk.ListInstanceTypesParams{}
+	if opts.Cloud != "" {
+		req.Providers = &[]string{opts.Cloud}
+	}
+	if opts.Region != "" {
+		req.Regions = &[]string{opts.Region}
+	}
+	items, err := api.ListInstanceTypes(cmd.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	items = filterInstanceTypes(items, opts)
+	sortInstanceTypes(items, opts.Sort)
+
+	if command.OutputJSON() {
+		command.PrintOutput(items)
+		return nil
+	}
+
+	printInstanceTypesTable(cmd.OutOrStdout(), items)
+	return nil
+}
+
+func isSupportedInstanceTypesSort(v string) bool {
+	return indexOfString(supportedInstanceTypesSort, v) >= 0
+}
+
+func filterInstanceTypes(items []sdk.InstanceType, opts instanceTypesListOptions) []sdk.InstanceType {
+	res := make([]sdk.InstanceType, 0, len(items))
+	for _, item := range items {
+		if item.Vcpu < opts.MinCPU || item.Ram < opts.MinRAM*1024 {
+			continue
+		}
+		if opts.MaxPrice > 0 {
+			price := instanceTypePrice(item)
+			if price < 0 || price > opts.MaxPrice {
+				continue
+			}
+		}
+		res = append(res, item)
+	}
+	return res
+}
+
+func sortInstanceTypes(items []sdk.InstanceType, by string) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		switch by {
+		case "price":
+			// Unknown prices go last.
+			if pa, pb := instanceTypePrice(a), instanceTypePrice(b); pa != pb {
+				return pb < 0 || (pa >= 0 && pa < pb)
+			}
+		case "cpu":
+			if a.Vcpu != b.Vcpu {
+				return a.Vcpu < b.Vcpu
+			}
+		case "ram":
+			if a.Ram != b.Ram {
+				return a.Ram < b.Ram
+			}
+		}
+		return a.InstanceType < b.InstanceType
+	})
+}
+
+func printInstanceTypesTable(out io.Writer, items []sdk.InstanceType) {
+	t := table.NewWriter()
+	t.SetStyle(command.DefaultTableStyle)
+	t.SetOutputMirror(out)
+	t.AppendHeader(table.Row{"Name", "Cloud", "Region", "vCPU", "RAM_GiB", "Price_Hourly"})
+	for _, item := range items {
+		t.AppendRow(table.Row{
+			item.InstanceType,
+			item.Provider,
+			item.Region,
+			item.Vcpu,
+			fmt.Sprintf("%.1f", float64(item.Ram)/1024),
+			item.Price,
+		})
+	}
+	t.Render()
+}
@@ -41,6 +41,10 @@ func newNodeCmd() *cobra.Command {
 	}
 }
 
+func isSpotNode(node sdk.Node) bool {
+	return node.SpotConfig != nil && node.SpotConfig.IsSpot
+}
+
 func getNode(cmd *cobra.Command, api client.Interface, clusterID string) (*sdk.Node, error) {
 	ctx := cmd.Context()
 	// Select node from interactive picker if no args passed.
@@ -52,11 +56,68 @@ func getNode(cmd *cobra.Command, api client.Interface, clusterID string) (*sdk.N
 		return node, err
 	}
 
+	return findNode(ctx, api, clusterID, cmd.Flags().Args()[0])
+}
+
+// getNodes gets nodes by names or IDs passed as args or by selector. Interactive picker is shown if neither is passed.
+func getNodes(cmd *cobra.Command, api client.Interface, clusterID, selector string) ([]sdk.Node, error) {
+	ctx := cmd.Context()
+	args := cmd.Flags().Args()
+	if selector != "" {
+		if len(args) > 0 {
+			return nil, errors.New("nodes can be passed either as arguments or by selector, not both")
+		}
+		sel, err := parseNodeSelector(selector)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := api.ListClusterNodes(ctx, sdk.ClusterId(clusterID))
+		if err != nil {
+			return nil, err
+		}
+		var res []sdk.Node
+		for _, node := range nodes {
+			if sel.matches(node) {
+				res = append(res, node)
+			}
+		}
+		if len(res) == 0 {
+			return nil, fmt.Errorf("no nodes found by selector %q", selector)
+		}
+		return res, nil
+	}
+
+	if len(args) == 0 {
+		node, err := selectNode(ctx, api, clusterID)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Node{*node}, nil
+	}
+
+	res := make([]sdk.Node, 0, len(args))
+	seen := make(map[string]bool, len(args))
+	for _, value := range args {
+		node, err := findNode(ctx, api, clusterID, value)
+		if err != nil {
+			return nil, err
+		}
+		// Same node can be passed both by name and ID.
+		if seen[*node.Id] {
+			continue
+		}
+		seen[*node.Id] = true
+		res = append(res, *node)
+	}
+	return res, nil
+}
+
+// findNode finds cluster node by ID or name.
+func findNode(ctx context.Context, api client.Interface, clusterID, value string) (*sdk.Node, error) {
 	// Try to search single node by uuid.
-	value := cmd.Flags().Args()[0]
 	uuidID, err := uuid.Parse(value)
 	if err == nil {
-		node, err := api.GetClusterNode(cmd.Context(), sdk.ClusterId(clusterID), uuidID.String())
+		node, err := api.GetClusterNode(ctx, sdk.ClusterId(clusterID), uuidID.String())
 		if err != nil {
 			return nil, err
 		}
@@ -76,13 +137,60 @@ func getNode(cmd *cobra.Command, api client.Interface, clusterID string) (*sdk.N
 	return nil, fmt.Errorf("node not found, searched by value=%s", value)
 }
 
+var nodeSelectorKeys = []string{"cloud", "role", "shape", "instance-type", "lifecycle", "phase"}
+
+// nodeSelector selects nodes by node fields, eg. cloud=aws,role=worker,lifecycle=spot.
+type nodeSelector map[string]string
+
+func parseNodeSelector(v string) (nodeSelector, error) {
+	sel := nodeSelector{}
+	for _, pair := range strings.Split(v, ",") {
+		p := strings.SplitN(pair, "=", 2)
+		if len(p) != 2 || strings.TrimSpace(p[1]) == "" {
+			return nil, fmt.Errorf("invalid node selector %q, expected key=value pairs, eg. cloud=aws,role=worker", v)
+		}
+		key := strings.TrimSpace(p[0])
+		if !isNodeSelectorKey(key) {
+			return nil, fmt.Errorf("unknown node selector key %q, allowed values: %s", key, strings.Join(nodeSelectorKeys, ","))
+		}
+		sel[key] = strings.TrimSpace(p[1])
+	}
+	return sel, nil
+}
+
+func isNodeSelectorKey(key string) bool {
+	return indexOfString(nodeSelectorKeys, key) >= 0
+}
+
+// matches returns true if node matches all selector fields. Deleted nodes are matched only if phase is selected explicitly.
+func (s nodeSelector) matches(node sdk.Node) bool {
+	phase := nodePhase(node)
+	if _, ok := s["phase"]; !ok && (phase == "deleting" || phase == "deleted") {
+		return false
+	}
+	fields := map[string]string{
+		"cloud":         string(node.Cloud),
+		"role":          string(node.Role),
+		"shape":         string(node.Shape),
+		"instance-type": node.InstanceType,
+		"lifecycle":     spotStatus(isSpotNode(node)),
+		"phase":         phase,
+	}
+	for k, v := range s {
+		if !strings.EqualFold(fields[k], v) {
+			return false
+		}
+	}
+	return true
+}
+
 func selectNode(ctx context.Context, api client.Interface, clusterID string) (*sdk.Node, error) {
 	displayName := func(item sdk.Node) string {
 		var phase string
 		if item.State != nil && item.State.Phase != nil {
 			phase = *item.State.Phase
 		}
-		return fmt.Sprintf("%-20s %s %s %s %s", *item.Name, phase, item.Cloud, item.Role, item.Shape)
+		return fmt.Sprintf("%-20s %s %s %s %s %s", *item.Name, phase, item.Cloud, item.Role, item.Shape, spotStatus(isSpotNode(item)))
 	}
 	items, err := api.ListClusterNodes(ctx, sdk.ClusterId(clusterID))
 	if err != nil {
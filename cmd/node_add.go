@@ -17,6 +17,7 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -33,6 +34,9 @@ type addNodeFlags struct {
 	Role         string `survey:"role"`
 	Shape        string `survey:"shape"`
 	InstanceType string `survey:"instanceType"`
+	Spot         bool   `survey:"spot"`
+	SpotMaxPrice string `survey:"spotMaxPrice"`
+	Wait         bool
 }
 
 var addNodeFlagsData addNodeFlags
@@ -55,6 +59,15 @@ func newNodeAddCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command
 Examples:
   # Add worker node on aws cloud.
   cast node add -c=my-cluster --cloud=aws --role=worker --shape=medium
+
+  # Add worker node with specific instance type.
+  cast node add -c=my-cluster --cloud=aws --role=worker --instance-type=m5.xlarge
+
+  # Add spot worker node with max price.
+  cast node add -c=my-cluster --cloud=aws --role=worker --shape=large --spot --spot-max-price=0.05
+
+  # Add worker node and wait until it's created.
+  cast node add -c=my-cluster --cloud=gcp --role=worker --shape=large --wait
 `,
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := handleAddNode(cmd, log, api); err != nil {
@@ -66,7 +79,10 @@ Examples:
 	cmd.PersistentFlags().StringVar(&addNodeFlagsData.Cloud, "cloud", "", fmt.Sprintf("node cloud name, possible values: %s)", strings.Join(supportedClouds, ",")))
 	cmd.PersistentFlags().StringVar(&addNodeFlagsData.Role, "role", "worker", fmt.Sprintf("node role, possible values: %s)", strings.Join(supportedNodeRoles, ",")))
 	cmd.PersistentFlags().StringVar(&addNodeFlagsData.Shape, "shape", "medium", fmt.Sprintf("node shape, possible values: %s)", strings.Join(supportedNodeShapes, ",")))
-	cmd.PersistentFlags().StringVar(&addNodeFlagsData.Shape, "instance-type", "", fmt.Sprintf("node instance type"))
+	cmd.PersistentFlags().StringVar(&addNodeFlagsData.InstanceType, "instance-type", "", "node instance type, see 'cast instance-types list' for available types")
+	cmd.PersistentFlags().BoolVar(&addNodeFlagsData.Spot, "spot", false, "create spot instance")
+	cmd.PersistentFlags().StringVar(&addNodeFlagsData.SpotMaxPrice, "spot-max-price", "", "optional max spot instance hourly price, applicable to aws only")
+	cmd.PersistentFlags().BoolVar(&addNodeFlagsData.Wait, "wait", false, "wait until operation finishes, eg. --wait=true")
 	return cmd
 }
 
@@ -77,35 +93,68 @@ func handleAddNode(cmd *cobra.Command, log logrus.FieldLogger, api client.Interf
 	}
 	ctx := cmd.Context()
 
+	// Ask node details interactively if cloud is not passed.
+	interactive := !cmd.Flags().Changed("cloud")
+	if interactive {
+		for _, name := range []string{"role", "shape", "instance-type", "spot", "spot-max-price"} {
+			if cmd.Flags().Changed(name) {
+				usagef(cmd, "--cloud is required when --%s is passed", name)
+			}
+		}
+	}
+
 	var node *sdk.Node
-	if cmd.Flags().NFlag() == 1 {
+	if interactive {
 		creds, err := api.ListCloudCredentials(ctx)
 		if err != nil {
 			return err
 		}
-		node, err = parseInteractiveAddNodeForm(cluster, creds)
+		node, err = parseInteractiveAddNodeForm(ctx, api, cluster, creds)
 		if err != nil {
 			return err
 		}
 	} else {
+		if addNodeFlagsData.InstanceType != "" {
+			if err := validateInstanceType(ctx, api, addNodeFlagsData.Cloud, addNodeFlagsData.InstanceType); err != nil {
+				return err
+			}
+		}
+		spot, err := toNodeSpotConfig(addNodeFlagsData.Cloud, addNodeFlagsData.Spot, addNodeFlagsData.SpotMaxPrice)
+		if err != nil {
+			return err
+		}
 		node = &sdk.Node{
 			Cloud:        sdk.CloudType(addNodeFlagsData.Cloud),
 			Role:         sdk.NodeType(addNodeFlagsData.Role),
 			Shape:        sdk.NodeShape(addNodeFlagsData.Shape),
 			InstanceType: addNodeFlagsData.InstanceType,
+			SpotConfig:   spot,
 		}
 	}
 
-	if err := api.AddClusterNode(ctx, sdk.ClusterId(cluster.Id), *node); err != nil {
+	events := newClusterEventsPrinter(log, api, cluster.Id)
+	if addNodeFlagsData.Wait {
+		events.skipExisting(ctx)
+	}
+	res, err := api.AddClusterNode(ctx, sdk.ClusterId(cluster.Id), *node)
+	if err != nil {
 		return err
 	}
 
-	log.Info("Cluster node creation is now in progress")
+	if !addNodeFlagsData.Wait {
+		log.Infof("Cluster node creation is now in progress, node_id=%s operation_id=%s", res.NodeId, res.OperationId)
+		return nil
+	}
 
+	log.Infof("Waiting for cluster node creation, node_id=%s operation_id=%s", res.NodeId, res.OperationId)
+	if err := waitOperationWithProgress(ctx, api, events, res.OperationId); err != nil {
+		return err
+	}
+	log.Info("Cluster node created")
 	return nil
 }
 
-func parseInteractiveAddNodeForm(cluster *sdk.KubernetesCluster, creds []sdk.CloudCredentials) (*sdk.Node, error) {
+func parseInteractiveAddNodeForm(ctx context.Context, api client.Interface, cluster *sdk.KubernetesCluster, creds []sdk.CloudCredentials) (*sdk.Node, error) {
 	var clusterClouds []string
 	for _, credID := range cluster.CloudCredentialsIDs {
 		for _, cred := range creds {
@@ -144,10 +193,70 @@ func parseInteractiveAddNodeForm(cluster *sdk.KubernetesCluster, creds []sdk.Clo
 		return nil, err
 	}
 
+	instanceType, err := selectInstanceType(ctx, api, addNodeFlagsData.Cloud)
+	if err != nil {
+		return nil, err
+	}
+	addNodeFlagsData.InstanceType = instanceType
+
+	if err := survey.AskOne(&survey.Confirm{
+		Message: "Create spot instance?",
+		Default: addNodeFlagsData.Role == string(sdk.NodeType_worker),
+	}, &addNodeFlagsData.Spot); err != nil {
+		return nil, err
+	}
+
+	// Max spot price is supported only on aws.
+	if addNodeFlagsData.Spot && addNodeFlagsData.Cloud == "aws" {
+		if err := survey.AskOne(&survey.Input{
+			Message: "Enter spot max price (optional):",
+		}, &addNodeFlagsData.SpotMaxPrice); err != nil {
+			return nil, err
+		}
+	}
+
+	spot, err := toNodeSpotConfig(addNodeFlagsData.Cloud, addNodeFlagsData.Spot, addNodeFlagsData.SpotMaxPrice)
+	if err != nil {
+		return nil, err
+	}
+
 	return &sdk.Node{
 		Cloud:        sdk.CloudType(addNodeFlagsData.Cloud),
 		Role:         sdk.NodeType(addNodeFlagsData.Role),
 		Shape:        sdk.NodeShape(addNodeFlagsData.Shape),
 		InstanceType: addNodeFlagsData.InstanceType,
+		SpotConfig:   spot,
 	}, nil
 }
+
+// selectInstanceType shows interactive cloud instance types selection list. Empty instance type is returned
+// if user chooses to select instance type by node shape.
+func selectInstanceType(ctx context.Context, api client.Interface, cloud string) (string, error) {
+	items, err := listCloudInstanceTypes(ctx, api, cloud)
+	if err != nil {
+		return "", err
+	}
+
+	const byShape = "by node shape"
+	options := []string{byShape}
+	for _, item := range items {
+		options = append(options, instanceTypeDisplayName(item))
+	}
+
+	var selected string
+	if err := survey.AskOne(&survey.Select{
+		Message:  "Select instance type:",
+		Options:  options,
+		Default:  byShape,
+		PageSize: 15,
+	}, &selected, survey.WithValidator(survey.Required)); err != nil {
+		return "", err
+	}
+
+	for _, item := range items {
+		if instanceTypeDisplayName(item) == selected {
+			return item.InstanceType, nil
+		}
+	}
+	return "", nil
+}
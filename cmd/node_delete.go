@@ -17,6 +17,11 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -25,12 +30,35 @@ import (
 	"github.com/castai/cli/pkg/client/sdk"
 )
 
-var flagDeleteClusterNodeConfirm bool
+var (
+	flagDeleteClusterNodeConfirm  bool
+	flagDeleteClusterNodeWait     bool
+	flagDeleteClusterNodeSelector string
+
+	flagDeleteClusterNodeDrainTimeout time.Duration
+	flagDeleteClusterNodeForce        bool
+)
 
 func newNodeDeleteCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delete <node_name_or_id>",
-		Short: "Delete clusters node",
+		Use:   "delete [node_name_or_id...]",
+		Short: "Delete clusters nodes",
+		Long: `
+Multiple nodes are deleted in a single request. Nodes can be passed as arguments or selected
+by node fields: cloud, role, shape, instance-type, lifecycle (spot or on-demand) and phase.
+Deletion of multiple nodes or nodes matching selector is refused if it would delete all cluster
+master nodes.
+
+Examples:
+  # Delete two nodes.
+  cast node delete -c=my-cluster worker-1 worker-2
+
+  # Delete all aws spot workers and wait until they are removed.
+  cast node delete -c=my-cluster --selector=cloud=aws,role=worker,lifecycle=spot --wait
+
+  # Drain node for up to 10 minutes and delete it even if draining does not finish in time.
+  cast node delete -c=my-cluster worker-1 --drain-timeout=10m --force --wait
+`,
 		Run: func(cmd *cobra.Command, args []string) {
 			if err := handleDeleteNode(cmd, log, api); err != nil {
 				log.Fatal(err)
@@ -39,19 +67,36 @@ func newNodeDeleteCmd(log logrus.FieldLogger, api client.Interface) *cobra.Comma
 	}
 	cmd.PersistentFlags().StringP(flagCluster, "c", "", "cluster name or ID")
 	cmd.PersistentFlags().BoolVarP(&flagDeleteClusterNodeConfirm, "yes", "y", false, "confirm cluster node deletion")
+	cmd.PersistentFlags().BoolVar(&flagDeleteClusterNodeWait, "wait", false, "wait until operation finishes, eg. --wait=true")
+	cmd.PersistentFlags().StringVarP(&flagDeleteClusterNodeSelector, "selector", "l", "", "select nodes by fields, eg. --selector=cloud=aws,role=worker")
+	cmd.PersistentFlags().DurationVar(&flagDeleteClusterNodeDrainTimeout, "drain-timeout", 0, "max time to wait for node drain before deletion, eg. --drain-timeout=10m")
+	cmd.PersistentFlags().BoolVar(&flagDeleteClusterNodeForce, "force", false, "delete node even if drain does not finish within drain timeout, eg. --force=true")
 	return cmd
 }
 
 func handleDeleteNode(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface) error {
+	drain, err := toNodeDrainOptions(cmd)
+	if err != nil {
+		return err
+	}
+
 	cluster, err := getClusterFromFlag(cmd, api)
 	if err != nil {
 		return err
 	}
 
-	node, err := getNode(cmd, api, cluster.Id)
+	nodes, err := getNodes(cmd, api, cluster.Id, flagDeleteClusterNodeSelector)
 	if err != nil {
 		return err
 	}
+	if flagDeleteClusterNodeSelector != "" || len(nodes) > 1 {
+		if err := checkMasterNodesRemain(cmd.Context(), api, cluster.Id, nodes); err != nil {
+			return err
+		}
+	}
+	if len(nodes) > 1 {
+		printNodesListTable(cmd.OutOrStdout(), nodes)
+	}
 
 	if !flagDeleteClusterNodeConfirm {
 		if err := survey.AskOne(&survey.Confirm{
@@ -66,11 +111,126 @@ func handleDeleteNode(cmd *cobra.Command, log logrus.FieldLogger, api client.Int
 		return nil
 	}
 
-	if err := api.DeleteClusterNode(cmd.Context(), sdk.ClusterId(cluster.Id), *node.Id); err != nil {
+	// Node delete endpoint doesn't accept drain options, so they are passed through node list update.
+	if len(nodes) > 1 || drain != nil {
+		return deleteNodes(cmd.Context(), log, api, cluster.Id, nodes, drain)
+	}
+
+	node := nodes[0]
+	ctx := cmd.Context()
+	events := newClusterEventsPrinter(log, api, cluster.Id)
+	if flagDeleteClusterNodeWait {
+		events.skipExisting(ctx)
+	}
+	res, err := api.DeleteClusterNode(ctx, sdk.ClusterId(cluster.Id), *node.Id)
+	if err != nil {
 		return err
 	}
 
-	log.Info("Cluster node deletion is now in progress")
+	if !flagDeleteClusterNodeWait {
+		log.Infof("Cluster node deletion is now in progress, operation_id=%s %s", res.OperationId, nodeDrainString(res.DrainTimeout, res.Force))
+		return nil
+	}
 
+	log.Infof("Waiting for cluster node deletion, operation_id=%s %s", res.OperationId, nodeDrainString(res.DrainTimeout, res.Force))
+	if err := waitOperationWithProgress(ctx, api, events, res.OperationId); err != nil {
+		return err
+	}
+	log.Infof("Cluster node %s deleted", nodeValueString(node.Name))
+	return nil
+}
+
+// checkMasterNodesRemain returns error if deleting given nodes would leave cluster without master nodes.
+func checkMasterNodesRemain(ctx context.Context, api client.Interface, clusterID string, nodes []sdk.Node) error {
+	all, err := api.ListClusterNodes(ctx, sdk.ClusterId(clusterID))
+	if err != nil {
+		return err
+	}
+	if masters := activeMasterNodes(all); len(masters) > 0 && len(masters) == len(activeMasterNodes(nodes)) {
+		return errors.New("selected nodes include all cluster master nodes, cluster can't be left without master nodes")
+	}
+	return nil
+}
+
+// nodeDrainOptions holds optional drain settings for node deletion.
+type nodeDrainOptions struct {
+	Timeout time.Duration
+	Force   *bool
+}
+
+// toNodeDrainOptions returns drain options from flags or nil when none of them are set.
+func toNodeDrainOptions(cmd *cobra.Command) (*nodeDrainOptions, error) {
+	flags := cmd.PersistentFlags()
+	if !flags.Changed("drain-timeout") && !flags.Changed("force") {
+		return nil, nil
+	}
+	if flagDeleteClusterNodeDrainTimeout < 0 || flagDeleteClusterNodeDrainTimeout%time.Second != 0 {
+		return nil, fmt.Errorf("drain timeout should be a non-negative whole number of seconds, got %s", flagDeleteClusterNodeDrainTimeout)
+	}
+	drain := &nodeDrainOptions{Timeout: flagDeleteClusterNodeDrainTimeout}
+	if flags.Changed("force") {
+		force := flagDeleteClusterNodeForce
+		drain.Force = &force
+	}
+	return drain, nil
+}
+
+// toDeletedNodes converts nodes to node list update deletions with optional drain settings.
+func toDeletedNodes(nodes []sdk.Node, drain *nodeDrainOptions) []sdk.DeletedNode {
+	deleted := make([]sdk.DeletedNode, len(nodes))
+	for i, node := range nodes {
+		deleted[i] = sdk.DeletedNode{Id: *node.Id}
+		if drain == nil {
+			continue
+		}
+		if drain.Timeout > 0 {
+			seconds := int(drain.Timeout.Seconds())
+			deleted[i].DrainTimeout = &seconds
+		}
+		deleted[i].Force = drain.Force
+	}
+	return deleted
+}
+
+// nodeDrainString formats applied node drain settings, unset settings are left to API defaults.
+func nodeDrainString(drainTimeout *int, force *bool) string {
+	timeout, forced := "default", "default"
+	if drainTimeout != nil {
+		timeout = (time.Duration(*drainTimeout) * time.Second).String()
+	}
+	if force != nil {
+		forced = fmt.Sprintf("%t", *force)
+	}
+	return fmt.Sprintf("drain_timeout=%s force=%s", timeout, forced)
+}
+
+// deleteNodes deletes nodes using single node list update.
+func deleteNodes(ctx context.Context, log logrus.FieldLogger, api client.Interface, clusterID string, nodes []sdk.Node, drain *nodeDrainOptions) error {
+	deleted := toDeletedNodes(nodes, drain)
+	ids := make([]string, len(nodes))
+	for i, node := range nodes {
+		ids[i] = *node.Id
+	}
+	events := newClusterEventsPrinter(log, api, clusterID)
+	if flagDeleteClusterNodeWait {
+		events.skipExisting(ctx)
+	}
+	if _, err := api.UpdateNodeList(ctx, sdk.ClusterId(clusterID), sdk.UpdateNodeListJSONRequestBody{Delete: &deleted}); err != nil {
+		return err
+	}
+	for i, node := range nodes {
+		log.Infof("Cluster node %s deletion requested, %s", nodeName(node), nodeDrainString(deleted[i].DrainTimeout, deleted[i].Force))
+	}
+
+	if !flagDeleteClusterNodeWait {
+		log.Infof("Cluster nodes deletion is now in progress, nodes=%d", len(nodes))
+		return nil
+	}
+
+	log.Infof("Waiting for cluster nodes deletion, nodes=%d", len(nodes))
+	if err := waitNodesDeletedWithProgress(ctx, api, events, ids); err != nil {
+		return err
+	}
+	log.Infof("Cluster nodes deleted, nodes=%d", len(nodes))
 	return nil
 }
@@ -0,0 +1,162 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+type nodeInterruptOptions struct {
+	Random   bool
+	Role     string
+	SpotOnly bool
+	Wait     bool
+	Yes      bool
+}
+
+func newNodeInterruptCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := nodeInterruptOptions{}
+	cmd := &cobra.Command{
+		Use:   "interrupt [node_name_or_id]",
+		Short: "Simulate cluster node interruption",
+		Long: `
+Interrupts node the same way as cloud provider interrupts spot instance. Node is picked from arguments,
+interactive picker or randomly from ready cluster nodes. Random node is picked from workers unless
+--role=master is passed.
+
+Examples:
+  # Interrupt given node.
+  cast node interrupt -c=my-cluster worker-1
+
+  # Interrupt random spot worker and wait until replacement node is ready.
+  cast node interrupt -c=my-cluster --random --spot-only --wait --yes
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleNodeInterrupt(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringP(flagCluster, "c", "", "cluster name or ID")
+	cmd.PersistentFlags().BoolVar(&opts.Random, "random", false, "interrupt random ready node")
+	cmd.PersistentFlags().StringVar(&opts.Role, "role", "worker", fmt.Sprintf("pick random node only with given role, possible values: %s", strings.Join(supportedNodeRoles, ",")))
+	cmd.PersistentFlags().BoolVar(&opts.SpotOnly, "spot-only", false, "pick random node only from spot instances")
+	cmd.PersistentFlags().BoolVar(&opts.Wait, "wait", false, "wait until replacement node is ready, eg. --wait=true")
+	cmd.PersistentFlags().BoolVarP(&opts.Yes, "yes", "y", false, "confirm node interruption")
+	return cmd
+}
+
+func handleNodeInterrupt(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts nodeInterruptOptions) error {
+	if !opts.Random && (cmd.Flags().Changed("role") || opts.SpotOnly) {
+		usagef(cmd, "--role and --spot-only can be used only with --random")
+	}
+	if indexOfString(supportedNodeRoles, opts.Role) < 0 {
+		usagef(cmd, "unknown node role %q, possible values: %s", opts.Role, strings.Join(supportedNodeRoles, ","))
+	}
+	if opts.Random && len(cmd.Flags().Args()) > 0 {
+		usagef(cmd, "node can be passed either as argument or picked with --random, not both")
+	}
+
+	cluster, err := getClusterFromFlag(cmd, api)
+	if err != nil {
+		return err
+	}
+	ctx := cmd.Context()
+
+	nodes, err := api.ListClusterNodes(ctx, sdk.ClusterId(cluster.Id))
+	if err != nil {
+		return err
+	}
+
+	var node *sdk.Node
+	if opts.Random {
+		candidates := nodeInterruptCandidates(nodes, opts.Role, opts.SpotOnly)
+		if len(candidates) == 0 {
+			return errors.New("no ready nodes found to interrupt")
+		}
+		rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+		node = &candidates[rnd.Intn(len(candidates))]
+		log.Infof("Picked node %s (%s) out of %d candidates", nodeValueString(node.Name), nodeSpecString(*node), len(candidates))
+	} else {
+		node, err = getNode(cmd, api, cluster.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !opts.Yes {
+		if err := survey.AskOne(&survey.Confirm{
+			Message: fmt.Sprintf("Interrupt node %s?", nodeValueString(node.Name)),
+		}, &opts.Yes); err != nil {
+			return err
+		}
+	}
+	if !opts.Yes {
+		log.Info("Cluster node interrupt canceled")
+		return nil
+	}
+
+	events := newClusterEventsPrinter(log, api, cluster.Id)
+	if opts.Wait {
+		events.skipExisting(ctx)
+	}
+	if err := api.InterruptClusterNode(ctx, sdk.ClusterId(cluster.Id), *node.Id); err != nil {
+		return err
+	}
+
+	if !opts.Wait {
+		log.Infof("Cluster node %s interruption is now in progress", nodeValueString(node.Name))
+		return nil
+	}
+
+	log.Infof("Cluster node %s interrupted, waiting for replacement node", nodeValueString(node.Name))
+	replacement, err := waitNodeReplacedWithProgress(ctx, log, api, events, *node, nodes)
+	if err != nil {
+		return err
+	}
+	log.Infof("Replacement node %s (%s) is ready", nodeValueString(replacement.Name), nodeSpecString(*replacement))
+	return nil
+}
+
+// nodeInterruptCandidates returns ready nodes which can be picked for random interruption.
+func nodeInterruptCandidates(nodes []sdk.Node, role string, spotOnly bool) []sdk.Node {
+	var res []sdk.Node
+	for _, node := range nodes {
+		if node.Id == nil || nodePhase(node) != "ready" {
+			continue
+		}
+		if role != "" && !strings.EqualFold(string(node.Role), role) {
+			continue
+		}
+		if spotOnly && !isSpotNode(node) {
+			continue
+		}
+		res = append(res, node)
+	}
+	return res
+}
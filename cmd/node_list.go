@@ -68,7 +68,7 @@ func printNodesListTable(out io.Writer, items []sdk.Node) {
 	t := table.NewWriter()
 	t.SetStyle(command.DefaultTableStyle)
 	t.SetOutputMirror(out)
-	t.AppendHeader(table.Row{"ID", "Name", "Cloud", "Role", "Shape", "Status", "Age", "Public_IP", "Private_IP"})
+	t.AppendHeader(table.Row{"ID", "Name", "Cloud", "Role", "Shape", "Instance_Type", "Lifecycle", "Status", "Age", "Public_IP", "Private_IP"})
 	for _, item := range items {
 		if item.Network == nil {
 			item.Network = &sdk.NodeNetwork{}
@@ -83,6 +83,8 @@ func printNodesListTable(out io.Writer, items []sdk.Node) {
 			item.Cloud,
 			item.Role,
 			item.Shape,
+			item.InstanceType,
+			spotStatus(isSpotNode(item)),
 			nodeValueString(item.State.Phase),
 			prettytime.Format(*item.CreatedAt),
 			item.Network.PublicIp,
@@ -0,0 +1,174 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+type nodeScaleOptions struct {
+	Cloud        string
+	Role         string
+	Shape        string
+	InstanceType string
+	Spot         bool
+	Count        int
+	Yes          bool
+}
+
+func newNodeScaleCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := nodeScaleOptions{}
+	cmd := &cobra.Command{
+		Use:   "scale",
+		Short: "Scale cluster nodes to given count",
+		Long: `
+Adds or deletes nodes of given cloud, role and shape to reach target count in a single request.
+Newest nodes are deleted first when scaling down.
+
+Examples:
+  # Scale gcp large workers to 5 nodes.
+  cast node scale -c=my-cluster --cloud=gcp --role=worker --shape=large --count=5
+
+  # Scale aws spot workers down to 2 nodes without confirmation.
+  cast node scale -c=my-cluster --cloud=aws --shape=medium --spot --count=2 --yes
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleNodeScale(cmd, log, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringP(flagCluster, "c", "", "cluster name or ID")
+	cmd.PersistentFlags().StringVar(&opts.Cloud, "cloud", "", fmt.Sprintf("node cloud name, possible values: %s", strings.Join(supportedClouds, ",")))
+	cmd.PersistentFlags().StringVar(&opts.Role, "role", "worker", fmt.Sprintf("node role, possible values: %s", strings.Join(supportedNodeRoles, ",")))
+	cmd.PersistentFlags().StringVar(&opts.Shape, "shape", "medium", fmt.Sprintf("node shape, possible values: %s", strings.Join(supportedNodeShapes, ",")))
+	cmd.PersistentFlags().StringVar(&opts.InstanceType, "instance-type", "", "node instance type, see 'cast instance-types list' for available types")
+	cmd.PersistentFlags().BoolVar(&opts.Spot, "spot", false, "scale spot instances")
+	cmd.PersistentFlags().IntVar(&opts.Count, "count", -1, "target nodes count")
+	cmd.PersistentFlags().BoolVarP(&opts.Yes, "yes", "y", false, "confirm nodes scaling")
+	cmd.MarkPersistentFlagRequired("cloud")
+	cmd.MarkPersistentFlagRequired("count")
+	return cmd
+}
+
+func handleNodeScale(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, opts nodeScaleOptions) error {
+	if opts.Count < 0 {
+		return errors.New("count should be zero or positive")
+	}
+	if opts.Count == 0 && opts.Role == string(sdk.NodeType_master) {
+		return errors.New("master nodes can't be scaled to zero, cluster needs at least one master node")
+	}
+	spec, err := parseNode(fmt.Sprintf("%s-%s-%s", opts.Cloud, opts.Role, opts.Shape))
+	if err != nil {
+		return err
+	}
+	if opts.Spot {
+		spec.SpotConfig = &sdk.NodeSpotConfig{IsSpot: true}
+	}
+
+	cluster, err := getClusterFromFlag(cmd, api)
+	if err != nil {
+		return err
+	}
+	ctx := cmd.Context()
+
+	if opts.InstanceType != "" {
+		if err := validateInstanceType(ctx, api, opts.Cloud, opts.InstanceType); err != nil {
+			return err
+		}
+		spec.InstanceType = opts.InstanceType
+	}
+
+	nodes, err := api.ListClusterNodes(ctx, sdk.ClusterId(cluster.Id))
+	if err != nil {
+		return err
+	}
+	req, current := toNodeScaleRequest(spec, nodes, opts.Count)
+	if req == nil {
+		log.Infof("Cluster already has %d %s nodes", current, nodeSpecString(spec))
+		return nil
+	}
+
+	if !opts.Yes {
+		if err := survey.AskOne(&survey.Confirm{
+			Message: fmt.Sprintf("Scale %s nodes from %d to %d?", nodeSpecString(spec), current, opts.Count),
+		}, &opts.Yes); err != nil {
+			return err
+		}
+	}
+	if !opts.Yes {
+		log.Info("Cluster nodes scale canceled")
+		return nil
+	}
+
+	if _, err := api.UpdateNodeList(ctx, sdk.ClusterId(cluster.Id), *req); err != nil {
+		return err
+	}
+	log.Infof("Cluster nodes scaling from %d to %d is now in progress. Check status by running 'cast node list -c %s'", current, opts.Count, cluster.Name)
+	return nil
+}
+
+// toNodeScaleRequest returns node list update which adds or deletes nodes matching spec to reach target count
+// and current count of matching nodes. Returned request is nil if nodes count already matches.
+func toNodeScaleRequest(spec sdk.Node, nodes []sdk.Node, count int) (*sdk.UpdateNodeListJSONRequestBody, int) {
+	var matching []sdk.Node
+	for _, node := range nodes {
+		if phase := nodePhase(node); phase == "deleting" || phase == "deleted" {
+			continue
+		}
+		// Nodes without id can't be deleted, so they are not counted either.
+		if node.Id == nil {
+			continue
+		}
+		if nodeMatchesSpec(node, spec) {
+			matching = append(matching, node)
+		}
+	}
+
+	current := len(matching)
+	switch {
+	case count > current:
+		add := make([]sdk.Node, count-current)
+		for i := range add {
+			add[i] = spec
+		}
+		return &sdk.UpdateNodeListJSONRequestBody{Add: &add}, current
+	case count < current:
+		// Delete newest nodes first.
+		sort.SliceStable(matching, func(i, j int) bool {
+			a, b := matching[i].CreatedAt, matching[j].CreatedAt
+			return a != nil && (b == nil || a.After(*b))
+		})
+		deleted := make([]sdk.DeletedNode, current-count)
+		for i := range deleted {
+			deleted[i] = sdk.DeletedNode{Id: *matching[i].Id}
+		}
+		return &sdk.UpdateNodeListJSONRequestBody{Delete: &deleted}, current
+	default:
+		return nil, current
+	}
+}
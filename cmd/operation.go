@@ -0,0 +1,94 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+	"github.com/castai/cli/pkg/prettytime"
+)
+
+const (
+	flagOperationExternal = "external"
+
+	defaultOperationWaitTimeout = 30 * time.Minute
+)
+
+func newOperationCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "operation",
+		Short: "Manage long running operations",
+	}
+}
+
+// operationGetter returns external cluster operations getter if --external flag is set.
+func operationGetter(cmd *cobra.Command, api client.Interface) client.OperationGetter {
+	external, _ := cmd.Flags().GetBool(flagOperationExternal)
+	if external {
+		return api.GetExternalClusterOperation
+	}
+	return api.GetOperation
+}
+
+// waitOperation waits until operation is done using default timeout.
+func waitOperation(ctx context.Context, get client.OperationGetter, operationID string) error {
+	ctx, cancel := context.WithTimeout(ctx, defaultOperationWaitTimeout)
+	defer cancel()
+	_, err := client.WaitOperation(ctx, get, operationID)
+	return err
+}
+
+func printOperationTable(out io.Writer, op *sdk.OperationResponse) {
+	t := table.NewWriter()
+	t.SetStyle(command.DefaultTableStyle)
+	t.SetOutputMirror(out)
+	t.AppendHeader(table.Row{"ID", "Status", "Created", "Finished", "Error"})
+	finished := ""
+	if op.FinishedAt != nil {
+		finished = prettytime.Format(*op.FinishedAt)
+	}
+	opErr := ""
+	if op.Error != nil {
+		opErr = op.Error.Reason + ": " + op.Error.Details
+	}
+	t.AppendRow(table.Row{
+		op.Id,
+		operationStatus(op),
+		prettytime.Format(op.CreatedAt),
+		finished,
+		opErr,
+	})
+	t.Render()
+}
+
+func operationStatus(op *sdk.OperationResponse) string {
+	if !op.Done {
+		return "running"
+	}
+	if op.Error != nil {
+		return "failed"
+	}
+	return "succeeded"
+}
@@ -0,0 +1,69 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+)
+
+type operationWaitOptions struct {
+	Timeout time.Duration
+}
+
+func newOperationWaitCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := operationWaitOptions{}
+	cmd := &cobra.Command{
+		Use:   "wait <operation_id>",
+		Short: "Wait until long running operation is done",
+		Long: `Wait polls operation status until it's done. Command fails if operation
+finishes with an error or timeout is reached.
+
+Examples:
+  # Wait for node creation operation.
+  cast operation wait 8f9b2c51-3f0e-4a8c-9b0a-6a1f2d6c2f0e --timeout=10m
+`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleOperationWait(cmd, log, api, args[0], opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().DurationVar(&opts.Timeout, "timeout", defaultOperationWaitTimeout, "maximum time to wait")
+	cmd.PersistentFlags().Bool(flagOperationExternal, false, "wait for external cluster operation")
+	return cmd
+}
+
+func handleOperationWait(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, operationID string, opts operationWaitOptions) error {
+	ctx, cancel := context.WithTimeout(cmd.Context(), opts.Timeout)
+	defer cancel()
+
+	log.Infof("Waiting for operation %s", operationID)
+	op, err := client.WaitOperation(ctx, operationGetter(cmd, api), operationID)
+	if err != nil {
+		return err
+	}
+
+	printOperationTable(cmd.OutOrStdout(), op)
+	return nil
+}
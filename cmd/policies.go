@@ -0,0 +1,85 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+func newPoliciesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "policies",
+		Short: "Manage cluster autoscaler policies",
+	}
+}
+
+// parsePolicies parses policies from YAML or JSON and validates them.
+func parsePolicies(data []byte) (*sdk.PoliciesConfig, error) {
+	var policies sdk.PoliciesConfig
+	if err := yaml.UnmarshalStrict(data, &policies); err != nil {
+		return nil, fmt.Errorf("parsing policies: %w", err)
+	}
+	if err := validatePolicies(&policies); err != nil {
+		return nil, err
+	}
+	return &policies, nil
+}
+
+func validatePolicies(policies *sdk.PoliciesConfig) error {
+	var errs []string
+
+	cpu := policies.ClusterLimits.Cpu
+	if cpu.MinCores < 0 {
+		errs = append(errs, "clusterLimits.cpu.minCores can't be negative")
+	}
+	if cpu.MaxCores < 0 {
+		errs = append(errs, "clusterLimits.cpu.maxCores can't be negative")
+	}
+	if cpu.MinCores > cpu.MaxCores {
+		errs = append(errs, fmt.Sprintf("clusterLimits.cpu.minCores (%d) should be less than or equal to maxCores (%d)", cpu.MinCores, cpu.MaxCores))
+	}
+
+	headroom := policies.UnschedulablePods.Headroom
+	if headroom.CpuPercentage < 0 || headroom.CpuPercentage > 100 {
+		errs = append(errs, fmt.Sprintf("unschedulablePods.headroom.cpuPercentage (%d) should be between 0 and 100", headroom.CpuPercentage))
+	}
+	if headroom.MemoryPercentage < 0 || headroom.MemoryPercentage > 100 {
+		errs = append(errs, fmt.Sprintf("unschedulablePods.headroom.memoryPercentage (%d) should be between 0 and 100", headroom.MemoryPercentage))
+	}
+
+	for _, cloud := range policies.SpotInstances.Clouds {
+		if !isSupportedCloud(cloud) {
+			errs = append(errs, fmt.Sprintf("spotInstances.clouds value %q is not valid, allowed values: %s", cloud, strings.Join(supportedClouds, ", ")))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New("invalid policies: " + strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func isSupportedCloud(cloud string) bool {
+	return indexOfString(supportedClouds, cloud) >= 0
+}
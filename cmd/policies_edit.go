@@ -0,0 +1,141 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"reflect"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+const (
+	defaultEditor = "vi"
+
+	policiesEditHeader = `# Edit cluster autoscaler policies below. Lines beginning with '#' are ignored.
+# Policies are validated and saved after you close the editor.
+`
+)
+
+func newPoliciesEditCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit",
+		Short: "Edit cluster autoscaler policies in $EDITOR",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handlePoliciesEdit(cmd, log, api); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringP(flagCluster, "c", "", "cluster name or ID")
+	return cmd
+}
+
+func handlePoliciesEdit(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface) error {
+	cluster, err := getClusterFromFlag(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	current, err := api.GetPolicies(cmd.Context(), sdk.ClusterId(cluster.Id))
+	if err != nil {
+		return err
+	}
+	b, err := yaml.Marshal(current)
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile("", "cast-policies-*.yaml")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(policiesEditHeader + string(b)); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	var policies *sdk.PoliciesConfig
+	for {
+		if err := runEditor(f.Name()); err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(f.Name())
+		if err != nil {
+			return err
+		}
+		policies, err = parsePolicies(data)
+		if err == nil {
+			break
+		}
+
+		log.Error(err)
+		editAgain := true
+		if err := survey.AskOne(&survey.Confirm{
+			Message: "Edit again?",
+			Default: true,
+		}, &editAgain); err != nil {
+			return err
+		}
+		if !editAgain {
+			log.Info("Policies edit canceled")
+			return nil
+		}
+	}
+
+	if reflect.DeepEqual(current, policies) {
+		log.Info("Policies not changed")
+		return nil
+	}
+
+	if _, err := api.UpsertPolicies(cmd.Context(), sdk.ClusterId(cluster.Id), sdk.UpsertPoliciesJSONRequestBody(*policies)); err != nil {
+		return err
+	}
+
+	log.Infof("Cluster %s policies updated", cluster.Name)
+	return nil
+}
+
+// runEditor opens file in the editor from EDITOR environment variable and waits until it's closed.
+func runEditor(path string) error {
+	editor := strings.Fields(os.Getenv("EDITOR"))
+	if len(editor) == 0 {
+		editor = []string{defaultEditor}
+	}
+	c := exec.Command(editor[0], append(editor[1:], path)...)
+	c.Stdin = os.Stdin
+	c.Stdout = os.Stdout
+	c.Stderr = os.Stderr
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("running editor %s: %w", editor[0], err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+func newPoliciesSetCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	var file string
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Set cluster autoscaler policies from file",
+		Long: `
+Policies file can be written in YAML or JSON, the format is the same as 'cast policies get' output.
+
+Examples:
+  # Copy policies from one cluster to another.
+  cast policies get -c my-demo-cluster > policies.yaml
+  cast policies set -c my-other-cluster -f policies.yaml
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handlePoliciesSet(cmd, log, api, file); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringP(flagCluster, "c", "", "cluster name or ID")
+	cmd.PersistentFlags().StringVarP(&file, "file", "f", "", "path to policies file, use - to read from stdin")
+	cmd.MarkPersistentFlagRequired("file")
+	return cmd
+}
+
+func handlePoliciesSet(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, file string) error {
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(file)
+	}
+	if err != nil {
+		return fmt.Errorf("reading policies: %w", err)
+	}
+
+	policies, err := parsePolicies(data)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := getClusterFromFlag(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	if _, err := api.UpsertPolicies(cmd.Context(), sdk.ClusterId(cluster.Id), sdk.UpsertPoliciesJSONRequestBody(*policies)); err != nil {
+		return err
+	}
+
+	log.Infof("Cluster %s policies updated", cluster.Name)
+	return nil
+}
@@ -37,15 +37,34 @@ func NewRootCmd(log logrus.FieldLogger, cfg *config.Config, api client.Interface
 	// Credentials.
 	credentialsCmd := newCredentialsCmd()
 	credentialsCmd.AddCommand(newCredentialsListCmd(log, api))
+	credentialsCmd.AddCommand(newCredentialsGetCmd(log, api))
+	credentialsCmd.AddCommand(newCredentialsCreateCmd(log, api))
+	credentialsCmd.AddCommand(newCredentialsDeleteCmd(log, api))
 	rootCmd.AddCommand(credentialsCmd)
 	// Cluster.
 	clusterCmd := newClusterCmd()
 	clusterCmd.AddCommand(newClusterListCmd(log, api))
 	clusterCmd.AddCommand(newClusterGetCmd(log, api))
 	clusterCmd.AddCommand(newClusterCreateCmd(log, cfg, api))
+	clusterCmd.AddCommand(newClusterApplyCmd(log, api))
+	clusterCmd.AddCommand(newClusterDiffCmd(log, api))
+	clusterCmd.AddCommand(newClusterExportCmd(log, api))
+	clusterCmd.AddCommand(newClusterUpdateCmd(log, api))
 	clusterCmd.AddCommand(newClusterGetKubeconfigCmd(log, api))
 	clusterCmd.AddCommand(newClusterDeleteCmd(log, api))
+	clusterCmd.AddCommand(newClusterArchiveCmd(log, api))
+	clusterCmd.AddCommand(newClusterHistoryCmd(log, api))
 	clusterCmd.AddCommand(newClusterReconcileCmd(log, api))
+	clusterCmd.AddCommand(newClusterHealthCmd(log, api))
+	clusterCmd.AddCommand(newClusterMetricsCmd(log, api))
+	clusterCmd.AddCommand(newClusterIngressCmd(log, api))
+	clusterCmd.AddCommand(newClusterPauseCmd(log, api))
+	clusterCmd.AddCommand(newClusterResumeCmd(log, api))
+	clusterScheduleCmd := newClusterScheduleCmd()
+	clusterScheduleCmd.AddCommand(newClusterScheduleGetCmd(log, api))
+	clusterScheduleCmd.AddCommand(newClusterScheduleSetCmd(log, api))
+	clusterScheduleCmd.AddCommand(newClusterScheduleDeleteCmd(log, api))
+	clusterCmd.AddCommand(clusterScheduleCmd)
 	rootCmd.AddCommand(clusterCmd)
 	// Cluster nodes.
 	nodeCmd := newNodeCmd()
@@ -53,13 +72,76 @@ func NewRootCmd(log logrus.FieldLogger, cfg *config.Config, api client.Interface
 	nodeCmd.AddCommand(newNodeSSHCmd(log, api, terminal, ipify))
 	nodeCmd.AddCommand(newNodeAddCmd(log, api))
 	nodeCmd.AddCommand(newNodeDeleteCmd(log, api))
+	nodeCmd.AddCommand(newNodeScaleCmd(log, api))
+	nodeCmd.AddCommand(newNodeInterruptCmd(log, api))
 	rootCmd.AddCommand(nodeCmd)
+	// Global server load balancing.
+	gslbCmd := newGslbCmd()
+	gslbCmd.AddCommand(newGslbSetCmd(log, api))
+	gslbCmd.AddCommand(newGslbDeleteCmd(log, api))
+	rootCmd.AddCommand(gslbCmd)
+	// External clusters.
+	externalCmd := newExternalCmd()
+	externalCmd.AddCommand(newExternalListCmd(log, api))
+	externalCmd.AddCommand(newExternalGetCmd(log, api))
+	externalCmd.AddCommand(newExternalRegisterCmd(log, api))
+	externalCmd.AddCommand(newExternalUpdateCmd(log, api))
+	externalCmd.AddCommand(newExternalDeleteCmd(log, api))
+	externalCmd.AddCommand(newExternalPauseCmd(log, api))
+	externalCmd.AddCommand(newExternalResumeCmd(log, api))
+	externalCmd.AddCommand(newExternalTokenCmd(log, api))
+	externalNodeCmd := newExternalNodeCmd()
+	externalNodeCmd.AddCommand(newExternalNodeListCmd(log, api))
+	externalNodeCmd.AddCommand(newExternalNodeAddCmd(log, api))
+	externalNodeCmd.AddCommand(newExternalNodeDeleteCmd(log, api))
+	externalCmd.AddCommand(externalNodeCmd)
+	rootCmd.AddCommand(externalCmd)
+	// Long running operations.
+	operationCmd := newOperationCmd()
+	operationCmd.AddCommand(newOperationGetCmd(log, api))
+	operationCmd.AddCommand(newOperationWaitCmd(log, api))
+	rootCmd.AddCommand(operationCmd)
+	// Cluster autoscaler policies.
+	policiesCmd := newPoliciesCmd()
+	policiesCmd.AddCommand(newPoliciesGetCmd(log, api))
+	policiesCmd.AddCommand(newPoliciesSetCmd(log, api))
+	policiesCmd.AddCommand(newPoliciesEditCmd(log, api))
+	rootCmd.AddCommand(policiesCmd)
+	// Cluster add-ons.
+	addonCmd := newAddonCmd()
+	addonCmd.AddCommand(newAddonCatalogCmd(log, api))
+	addonCmd.AddCommand(newAddonListCmd(log, api))
+	addonCmd.AddCommand(newAddonInstallCmd(log, api))
+	addonCmd.AddCommand(newAddonUpgradeCmd(log, api))
+	addonCmd.AddCommand(newAddonUninstallCmd(log, api))
+	rootCmd.AddCommand(addonCmd)
+	// Audit.
+	auditCmd := newAuditCmd()
+	auditCmd.AddCommand(newAuditListCmd(log, api))
+	rootCmd.AddCommand(auditCmd)
+	// Usage.
+	usageCmd := newUsageCmd()
+	usageCmd.AddCommand(newUsageReportCmd(log, api))
+	rootCmd.AddCommand(usageCmd)
+	// API access tokens.
+	tokenCmd := newTokenCmd()
+	tokenCmd.AddCommand(newTokenListCmd(log, api))
+	tokenCmd.AddCommand(newTokenGetCmd(log, api))
+	tokenCmd.AddCommand(newTokenCreateCmd(log, cfg, api))
+	tokenCmd.AddCommand(newTokenDisableCmd(log, api))
+	tokenCmd.AddCommand(newTokenEnableCmd(log, api))
+	tokenCmd.AddCommand(newTokenRevokeCmd(log, api))
+	rootCmd.AddCommand(tokenCmd)
 	// Completion.
 	rootCmd.AddCommand(newCompletionCmd())
 	// Region.
 	regionCmd := newRegionCmd()
 	regionCmd.AddCommand(newRegionListCmd(log, api))
 	rootCmd.AddCommand(regionCmd)
+	// Instance types.
+	instanceTypesCmd := newInstanceTypesCmd()
+	instanceTypesCmd.AddCommand(newInstanceTypesListCmd(log, api))
+	rootCmd.AddCommand(instanceTypesCmd)
 	// Version.
 	rootCmd.AddCommand(newVersionCmd())
 
@@ -0,0 +1,152 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/google/uuid"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+	"github.com/castai/cli/pkg/prettytime"
+)
+
+func newTokenCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "token",
+		Short: "Manage API access tokens",
+	}
+}
+
+// getAuthToken gets auth token by name or ID passed as first argument or shows interactive selection list.
+func getAuthToken(cmd *cobra.Command, api client.Interface) (*sdk.AuthToken, error) {
+	ctx := cmd.Context()
+	if len(cmd.Flags().Args()) == 0 {
+		return selectAuthToken(ctx, api)
+	}
+
+	value := cmd.Flags().Args()[0]
+	uuidID, err := uuid.Parse(value)
+	if err == nil {
+		return api.GetAuthToken(ctx, sdk.AuthTokenId(uuidID.String()))
+	}
+
+	items, err := api.ListAuthTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if strings.EqualFold(item.Name, value) {
+			return &item, nil
+		}
+	}
+	return nil, fmt.Errorf("token not found, id=%s", value)
+}
+
+// selectAuthToken shows interactive auth tokens selection list and returns selected token.
+func selectAuthToken(ctx context.Context, api client.Interface) (*sdk.AuthToken, error) {
+	items, err := api.ListAuthTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.New("no tokens found")
+	}
+	selectList := make([]string, len(items))
+	for i, item := range items {
+		selectList[i] = item.Name
+	}
+
+	var selected string
+	prompt := &survey.Select{
+		Message: "Select token:",
+		Options: selectList,
+		Default: selectList[0],
+	}
+
+	if err := survey.AskOne(prompt, &selected, survey.WithValidator(survey.Required)); err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if item.Name == selected {
+			return &item, nil
+		}
+	}
+
+	return nil, errors.New("token not found")
+}
+
+func handleTokenSetActive(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, active bool) error {
+	token, err := getAuthToken(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	if token.Active == active {
+		log.Infof("Token %s is already %s", token.Name, tokenStatus(active))
+		return nil
+	}
+
+	res, err := api.UpdateAuthToken(cmd.Context(), sdk.AuthTokenId(token.Id), sdk.UpdateAuthTokenJSONRequestBody{
+		Active: active,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Token %s is now %s", res.Name, tokenStatus(res.Active))
+	return nil
+}
+
+func printTokensListTable(out io.Writer, items []sdk.AuthToken) {
+	t := table.NewWriter()
+	t.SetStyle(command.DefaultTableStyle)
+	t.SetOutputMirror(out)
+	t.AppendHeader(table.Row{"ID", "Name", "Status", "Last_Used", "Age"})
+	for _, item := range items {
+		lastUsed := "never"
+		if item.LastUsedAt != nil {
+			lastUsed = prettytime.Format(*item.LastUsedAt)
+		}
+		t.AppendRow(table.Row{
+			item.Id,
+			item.Name,
+			tokenStatus(item.Active),
+			lastUsed,
+			prettytime.Format(item.CreatedAt),
+		})
+	}
+	t.Render()
+}
+
+func tokenStatus(active bool) string {
+	if active {
+		return "active"
+	}
+	return "disabled"
+}
@@ -0,0 +1,94 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+	"github.com/castai/cli/pkg/config"
+)
+
+type tokenCreateOptions struct {
+	Name string
+	Save bool
+}
+
+func newTokenCreateCmd(log logrus.FieldLogger, cfg *config.Config, api client.Interface) *cobra.Command {
+	opts := tokenCreateOptions{}
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create API access token",
+		Long: `
+Token secret is shown only once after creation. Only the secret is written to stdout so it can be
+safely redirected, eg. cast token create --name=ci > token.txt
+
+Examples:
+  # Create new token and use it for this CLI.
+  cast token create --name=my-laptop --save
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleTokenCreate(cmd, log, cfg, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVar(&opts.Name, "name", "", "token name, must be unique among active tokens")
+	cmd.PersistentFlags().BoolVar(&opts.Save, "save", false, "save created token to CLI configuration instead of printing it")
+	cmd.MarkPersistentFlagRequired("name")
+	command.AddJSONOutput(cmd)
+	return cmd
+}
+
+func handleTokenCreate(cmd *cobra.Command, log logrus.FieldLogger, cfg *config.Config, api client.Interface, opts tokenCreateOptions) error {
+	res, err := api.CreateAuthToken(cmd.Context(), sdk.CreateAuthTokenJSONRequestBody{
+		Name: opts.Name,
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.Save {
+		if err := config.SaveAccessToken(res.Token); err != nil {
+			return fmt.Errorf("saving configuration: %w", err)
+		}
+		cfg.AccessToken = res.Token
+		configPath, err := config.GetPath()
+		if err != nil {
+			return err
+		}
+		log.Infof("Token %s created and saved to %s", res.Name, configPath)
+		if config.AccessTokenFromEnv() {
+			log.Warn("CASTAI_API_TOKEN env variable is set and overrides saved token, unset it to use the new token")
+		}
+		return nil
+	}
+
+	if command.OutputJSON() {
+		command.PrintOutput(res)
+		return nil
+	}
+
+	log.Infof("Token %s created. Copy the token now, it will not be shown again", res.Name)
+	fmt.Fprintln(cmd.OutOrStdout(), res.Token)
+	return nil
+}
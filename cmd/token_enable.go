@@ -0,0 +1,36 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+)
+
+func newTokenEnableCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	return &cobra.Command{
+		Use:   "enable <token_name_or_id>",
+		Short: "Enable previously disabled API access token",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleTokenSetActive(cmd, log, api, true); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+}
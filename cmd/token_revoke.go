@@ -0,0 +1,68 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+func newTokenRevokeCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	var confirm bool
+	cmd := &cobra.Command{
+		Use:   "revoke <token_name_or_id>",
+		Short: "Revoke API access token",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleTokenRevoke(cmd, log, api, confirm); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().BoolVarP(&confirm, "yes", "y", false, "confirm token revoke")
+	return cmd
+}
+
+func handleTokenRevoke(cmd *cobra.Command, log logrus.FieldLogger, api client.Interface, confirm bool) error {
+	token, err := getAuthToken(cmd, api)
+	if err != nil {
+		return err
+	}
+
+	if !confirm {
+		if err := survey.AskOne(&survey.Confirm{
+			Message: "Are you sure?",
+		}, &confirm); err != nil {
+			return err
+		}
+	}
+
+	if !confirm {
+		log.Info("Token revoke canceled")
+		return nil
+	}
+
+	if err := api.DeleteAuthToken(cmd.Context(), sdk.AuthTokenId(token.Id)); err != nil {
+		return err
+	}
+
+	log.Infof("Token %s revoked", token.Name)
+	return nil
+}
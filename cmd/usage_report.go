@@ -0,0 +1,264 @@
+/*
+Copyright © 2021 CAST AI
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/castai/cli/pkg/client"
+	"github.com/castai/cli/pkg/client/sdk"
+	"github.com/castai/cli/pkg/command"
+)
+
+const (
+	usageReportFormatTable = "table"
+	usageReportFormatCSV   = "csv"
+	usageReportFormatJSON  = "json"
+)
+
+type usageReportOptions struct {
+	From    string
+	To      string
+	Cluster string
+	Format  string
+}
+
+// usageReport holds usage rows per cluster and interval and their total across all clusters.
+type usageReport struct {
+	Rows  []usageReportRow `json:"rows"`
+	Total usageReportTotal `json:"total"`
+}
+
+type usageReportRow struct {
+	ClusterID     string `json:"clusterId"`
+	ClusterName   string `json:"clusterName"`
+	From          string `json:"from"`
+	To            string `json:"to"`
+	CPUHours      int    `json:"cpuHours"`
+	MemoryMBHours int    `json:"memoryMbHours"`
+}
+
+type usageReportTotal struct {
+	From          string `json:"from"`
+	To            string `json:"to"`
+	CPUHours      int    `json:"cpuHours"`
+	MemoryMBHours int    `json:"memoryMbHours"`
+}
+
+func newUsageReportCmd(log logrus.FieldLogger, api client.Interface) *cobra.Command {
+	opts := usageReportOptions{}
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Show clusters cpu and memory usage report",
+		Long: `
+Usage is reported per cluster and interval together with total usage of all reported clusters.
+All clusters are included unless --cluster is passed. By default usage of the current month is reported.
+
+Examples:
+  # Export January usage of all clusters for chargeback.
+  cast usage report --from=2021-01-01 --to=2021-02-01 --format=csv > usage.csv
+
+  # Show usage of single cluster for the last 7 days.
+  cast usage report --cluster=my-cluster --from=7d
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := handleUsageReport(cmd, api, opts); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	cmd.PersistentFlags().StringVar(&opts.From, "from", "", "report usage after given time, eg. --from=2021-01-01 or --from=7d")
+	cmd.PersistentFlags().StringVar(&opts.To, "to", "", "report usage before given time, eg. --to=2021-02-01, defaults to now")
+	cmd.PersistentFlags().StringVarP(&opts.Cluster, flagCluster, "c", "", "report usage of given cluster name or ID only")
+	cmd.PersistentFlags().StringVar(&opts.Format, "format", usageReportFormatTable, "report format, available values: table, csv, json")
+	return cmd
+}
+
+func handleUsageReport(cmd *cobra.Command, api client.Interface, opts usageReportOptions) error {
+	switch opts.Format {
+	case usageReportFormatTable, usageReportFormatCSV, usageReportFormatJSON:
+	default:
+		usagef(cmd, "unknown report format %q, available values: table, csv, json", opts.Format)
+	}
+
+	from, to, err := usageReportPeriod(opts, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	ctx := cmd.Context()
+	var clusters []sdk.KubernetesCluster
+	if opts.Cluster != "" {
+		cluster, err := getCluster(ctx, api, opts.Cluster)
+		if err != nil {
+			return err
+		}
+		clusters = append(clusters, *cluster)
+	} else {
+		clusters, err = api.ListKubernetesClusters(ctx, &sdk.ListKubernetesClustersParams{})
+		if err != nil {
+			return err
+		}
+	}
+
+	fromDate := sdk.FilterFromDate(from.Format(time.RFC3339))
+	toDate := sdk.FilterToDate(to.Format(time.RFC3339))
+	rows := []usageReportRow{}
+	for _, cluster := range clusters {
+		clusterID := sdk.FilterClusterId(cluster.Id)
+		report, err := api.GetUsageReport(ctx, &sdk.GetUsageReportParams{
+			ClusterId: &clusterID,
+			FromDate:  &fromDate,
+			ToDate:    &toDate,
+		})
+		if err != nil {
+			return err
+		}
+		for _, usage := range report.Dates {
+			rows = append(rows, usageReportRow{
+				ClusterID:     cluster.Id,
+				ClusterName:   cluster.Name,
+				From:          usage.From,
+				To:            usage.To,
+				CPUHours:      usage.Cpu,
+				MemoryMBHours: usage.Memory,
+			})
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		if rows[i].ClusterName != rows[j].ClusterName {
+			return rows[i].ClusterName < rows[j].ClusterName
+		}
+		return rows[i].From < rows[j].From
+	})
+
+	report := toUsageReport(rows, from, to)
+	out := cmd.OutOrStdout()
+	switch opts.Format {
+	case usageReportFormatCSV:
+		return writeUsageReportCSV(out, report)
+	case usageReportFormatJSON:
+		b, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(b))
+	default:
+		printUsageReportTable(out, report)
+	}
+	return nil
+}
+
+// toUsageReport sums rows usage into the report period total.
+func toUsageReport(rows []usageReportRow, from, to time.Time) *usageReport {
+	report := &usageReport{
+		Rows: rows,
+		Total: usageReportTotal{
+			From: from.Format(time.RFC3339),
+			To:   to.Format(time.RFC3339),
+		},
+	}
+	for _, row := range rows {
+		report.Total.CPUHours += row.CPUHours
+		report.Total.MemoryMBHours += row.MemoryMBHours
+	}
+	return report
+}
+
+// usageReportPeriod returns report period from options. Period defaults to the current month.
+func usageReportPeriod(opts usageReportOptions, now time.Time) (time.Time, time.Time, error) {
+	from := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	to := now
+	var err error
+	if opts.From != "" {
+		if from, err = parseTimeFlag(opts.From); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	if opts.To != "" {
+		if to, err = parseTimeFlag(opts.To); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, errors.New("from time should be before to time")
+	}
+	return from, to, nil
+}
+
+func printUsageReportTable(out io.Writer, report *usageReport) {
+	t := table.NewWriter()
+	t.SetStyle(command.DefaultTableStyle)
+	t.SetOutputMirror(out)
+	t.AppendHeader(table.Row{"Cluster", "From", "To", "CPU_Hours", "Memory_MB_Hours"})
+	for _, row := range report.Rows {
+		t.AppendRow(table.Row{
+			row.ClusterName,
+			row.From,
+			row.To,
+			row.CPUHours,
+			row.MemoryMBHours,
+		})
+	}
+	t.AppendFooter(table.Row{"Total", report.Total.From, report.Total.To, report.Total.CPUHours, report.Total.MemoryMBHours})
+	t.Render()
+}
+
+// writeUsageReportCSV writes report rows and total row with empty cluster ID.
+func writeUsageReportCSV(out io.Writer, report *usageReport) error {
+	w := csv.NewWriter(out)
+	if err := w.Write([]string{"cluster_id", "cluster", "from", "to", "cpu_hours", "memory_mb_hours"}); err != nil {
+		return err
+	}
+	for _, row := range report.Rows {
+		record := []string{
+			row.ClusterID,
+			row.ClusterName,
+			row.From,
+			row.To,
+			strconv.Itoa(row.CPUHours),
+			strconv.Itoa(row.MemoryMBHours),
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	total := []string{
+		"",
+		"total",
+		report.Total.From,
+		report.Total.To,
+		strconv.Itoa(report.Total.CPUHours),
+		strconv.Itoa(report.Total.MemoryMBHours),
+	}
+	if err := w.Write(total); err != nil {
+		return err
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -17,4 +17,5 @@ require (
 	golang.org/x/term v0.0.0-20201210144234-2321bbc49cbf
 	gopkg.in/yaml.v2 v2.3.0
 	k8s.io/client-go v0.19.0
+	sigs.k8s.io/yaml v1.2.0
 )
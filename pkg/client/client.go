@@ -21,22 +21,69 @@ const (
 
 type Interface interface {
 	CreateNewCluster(ctx context.Context, req sdk.CreateNewClusterJSONRequestBody) (*sdk.KubernetesCluster, error)
+	PlanClusterPrice(ctx context.Context, req sdk.PlanClusterPriceJSONRequestBody) (*sdk.ClusterCostEstimate, error)
 	GetCluster(ctx context.Context, req sdk.ClusterId) (*sdk.KubernetesCluster, error)
+	UpdateCluster(ctx context.Context, clusterID sdk.ClusterId, req sdk.UpdateClusterJSONRequestBody) (*sdk.KubernetesCluster, error)
 	DeleteCluster(ctx context.Context, req sdk.ClusterId) error
 	ListRegions(ctx context.Context) ([]sdk.CastRegion, error)
+	ListInstanceTypes(ctx context.Context, req *sdk.ListInstanceTypesParams) ([]sdk.InstanceType, error)
 	ListCloudCredentials(ctx context.Context) ([]sdk.CloudCredentials, error)
+	GetCloudCredentials(ctx context.Context, credentialsID sdk.CredentialsId) (*sdk.CloudCredentials, error)
+	CreateCloudCredentials(ctx context.Context, req sdk.CreateCloudCredentialsJSONRequestBody) (*sdk.CloudCredentials, error)
+	DeleteCloudCredentials(ctx context.Context, credentialsID sdk.CredentialsId) error
 	GetClusterKubeconfig(ctx context.Context, req sdk.ClusterId) ([]byte, error)
 	ListKubernetesClusters(ctx context.Context, req *sdk.ListKubernetesClustersParams) ([]sdk.KubernetesCluster, error)
 	ListClusterNodes(ctx context.Context, req sdk.ClusterId) ([]sdk.Node, error)
-	AddClusterNode(ctx context.Context, clusterID sdk.ClusterId, node sdk.Node) error
-	DeleteClusterNode(ctx context.Context, clusterID sdk.ClusterId, nodeID string) error
+	AddClusterNode(ctx context.Context, clusterID sdk.ClusterId, node sdk.Node) (*sdk.AddNodeResult, error)
+	DeleteClusterNode(ctx context.Context, clusterID sdk.ClusterId, nodeID string) (*sdk.DeleteNodeResult, error)
+	UpdateNodeList(ctx context.Context, clusterID sdk.ClusterId, req sdk.UpdateNodeListJSONRequestBody) ([]sdk.Node, error)
+	InterruptClusterNode(ctx context.Context, clusterID sdk.ClusterId, nodeID string) error
 	ListAuthTokens(ctx context.Context) ([]sdk.AuthToken, error)
+	GetAuthToken(ctx context.Context, tokenID sdk.AuthTokenId) (*sdk.AuthToken, error)
+	CreateAuthToken(ctx context.Context, req sdk.CreateAuthTokenJSONRequestBody) (*sdk.AuthTokenCreateResponse, error)
+	UpdateAuthToken(ctx context.Context, tokenID sdk.AuthTokenId, req sdk.UpdateAuthTokenJSONRequestBody) (*sdk.AuthToken, error)
+	DeleteAuthToken(ctx context.Context, tokenID sdk.AuthTokenId) error
 	FeedbackEvents(ctx context.Context, req sdk.ClusterId) ([]sdk.KubernetesClusterFeedbackEvent, error)
 	SetupNodeSSH(ctx context.Context, clusterID sdk.ClusterId, nodeID string, req sdk.SetupNodeSshJSONRequestBody) error
 	CloseNodeSSH(ctx context.Context, clusterID sdk.ClusterId, nodeID string) error
 	GetClusterNode(ctx context.Context, clusterID sdk.ClusterId, nodeID string) (*sdk.Node, error)
 	TriggerClusterReconcile(ctx context.Context, clusterID sdk.ClusterId) error
+	PauseCluster(ctx context.Context, clusterID sdk.ClusterId, req sdk.PauseClusterJSONRequestBody) (*sdk.KubernetesCluster, error)
+	ResumeCluster(ctx context.Context, clusterID sdk.ClusterId) (*sdk.KubernetesCluster, error)
+	GetClusterPauseSchedule(ctx context.Context, clusterID sdk.ClusterId) (*sdk.PauseSchedule, error)
+	SetClusterPauseSchedule(ctx context.Context, clusterID sdk.ClusterId, req sdk.SetClusterPauseScheduleJSONRequestBody) (*sdk.PauseSchedule, error)
+	DeleteClusterPauseSchedule(ctx context.Context, clusterID sdk.ClusterId) error
+	GetPolicies(ctx context.Context, clusterID sdk.ClusterId) (*sdk.PoliciesConfig, error)
+	UpsertPolicies(ctx context.Context, clusterID sdk.ClusterId, req sdk.UpsertPoliciesJSONRequestBody) (*sdk.PoliciesConfig, error)
 	GetClusterFeedbackEvents(ctx context.Context, clusterID sdk.ClusterId) ([]sdk.KubernetesClusterFeedbackEvent, error)
+	GetClusterHealth(ctx context.Context, clusterID sdk.ClusterId) (*sdk.ClusterHealth, error)
+	GetClusterMetrics(ctx context.Context, clusterID sdk.ClusterId, metricsType sdk.MetricsType) (*sdk.ClusterMetrics, error)
+	ArchiveCluster(ctx context.Context, clusterID sdk.ClusterId) error
+	GetClusterAuditLog(ctx context.Context, clusterID sdk.ClusterId) ([]sdk.AuditLogEvent, error)
+	GetClusterIngressController(ctx context.Context, clusterID sdk.ClusterId) (*sdk.KubernetesIngressController, error)
+	CreateOrUpdateGslb(ctx context.Context, req sdk.CreateOrUpdateGslbJSONRequestBody) (*sdk.GSLBResponse, error)
+	DeleteGslb(ctx context.Context, req sdk.DeleteGslbJSONRequestBody) error
+	ListAddons(ctx context.Context, req *sdk.ListAddonsParams) ([]sdk.Addon, error)
+	GetClusterAddons(ctx context.Context, clusterID sdk.ClusterId) ([]sdk.ClusterAddon, error)
+	GetClusterAddon(ctx context.Context, clusterID sdk.ClusterId, repository, name string) (*sdk.ClusterAddon, error)
+	InstallClusterAddon(ctx context.Context, clusterID sdk.ClusterId, req sdk.InstallClusterAddonJSONRequestBody) (*sdk.ClusterAddon, error)
+	UpdateClusterAddon(ctx context.Context, clusterID sdk.ClusterId, repository, name string, req sdk.UpdateClusterAddonJSONRequestBody) (*sdk.ClusterAddon, error)
+	DeleteClusterAddon(ctx context.Context, clusterID sdk.ClusterId, repository, name string) error
+	ListAuditEvents(ctx context.Context, req *sdk.ListAuditEventsParams) (*sdk.AuditEventList, error)
+	GetUsageReport(ctx context.Context, req *sdk.GetUsageReportParams) (*sdk.ResourceUsageReport, error)
+	ListExternalClusters(ctx context.Context) ([]sdk.ExternalCluster, error)
+	RegisterExternalCluster(ctx context.Context, req sdk.RegisterExternalClusterJSONRequestBody) (*sdk.ExternalCluster, error)
+	GetExternalCluster(ctx context.Context, clusterID string) (*sdk.ExternalCluster, error)
+	UpdateExternalCluster(ctx context.Context, clusterID string, req sdk.UpdateExternalClusterJSONRequestBody) (*sdk.ExternalCluster, error)
+	DeleteExternalCluster(ctx context.Context, clusterID string) error
+	PauseExternalCluster(ctx context.Context, clusterID string) (*sdk.ExternalCluster, error)
+	ResumeExternalCluster(ctx context.Context, clusterID string) (*sdk.ExternalCluster, error)
+	GetExternalClustersToken(ctx context.Context) (string, error)
+	ListExternalClusterNodes(ctx context.Context, clusterID string) ([]sdk.ExternalClusterNode, error)
+	AddExternalClusterNode(ctx context.Context, clusterID string, req sdk.AddExternalClusterNodeJSONRequestBody) (*sdk.ExternalClusterAddNodeResult, error)
+	DeleteExternalClusterNode(ctx context.Context, clusterID, nodeID string) (*sdk.ExternalClusterDeleteNodeResult, error)
+	GetExternalClusterOperation(ctx context.Context, operationID string) (*sdk.OperationResponse, error)
+	GetOperation(ctx context.Context, operationID string) (*sdk.OperationResponse, error)
 }
 
 func New(cfg *config.Config, log logrus.FieldLogger) (Interface, error) {
@@ -87,6 +134,77 @@ func (c *client) GetClusterFeedbackEvents(ctx context.Context, clusterID sdk.Clu
 	return resp.JSON200.Items, nil
 }
 
+func (c *client) GetClusterHealth(ctx context.Context, clusterID sdk.ClusterId) (*sdk.ClusterHealth, error) {
+	resp, err := c.api.GetClusterHealthWithResponse(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) GetClusterMetrics(ctx context.Context, clusterID sdk.ClusterId, metricsType sdk.MetricsType) (*sdk.ClusterMetrics, error) {
+	resp, err := c.api.GetClusterMetricsWithResponse(ctx, clusterID, &sdk.GetClusterMetricsParams{MetricsType: &metricsType})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) ArchiveCluster(ctx context.Context, clusterID sdk.ClusterId) error {
+	resp, err := c.api.ArchiveClusterWithResponse(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	return c.checkResponse(resp, err, http.StatusOK)
+}
+
+func (c *client) GetClusterAuditLog(ctx context.Context, clusterID sdk.ClusterId) ([]sdk.AuditLogEvent, error) {
+	resp, err := c.api.GetClusterAuditLogWithResponse(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200.Items, nil
+}
+
+func (c *client) GetClusterIngressController(ctx context.Context, clusterID sdk.ClusterId) (*sdk.KubernetesIngressController, error) {
+	resp, err := c.api.GetClusterIngressControllerWithResponse(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) CreateOrUpdateGslb(ctx context.Context, req sdk.CreateOrUpdateGslbJSONRequestBody) (*sdk.GSLBResponse, error) {
+	resp, err := c.api.CreateOrUpdateGslbWithResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) DeleteGslb(ctx context.Context, req sdk.DeleteGslbJSONRequestBody) error {
+	resp, err := c.api.DeleteGslbWithResponse(ctx, req)
+	if err != nil {
+		return err
+	}
+	return c.checkResponse(resp, err, http.StatusNoContent)
+}
+
 func (c *client) TriggerClusterReconcile(ctx context.Context, clusterID sdk.ClusterId) error {
 	resp, err := c.api.TriggerClusterReconcileWithResponse(ctx, clusterID)
 	if err != nil {
@@ -98,6 +216,83 @@ func (c *client) TriggerClusterReconcile(ctx context.Context, clusterID sdk.Clus
 	return nil
 }
 
+func (c *client) PauseCluster(ctx context.Context, clusterID sdk.ClusterId, req sdk.PauseClusterJSONRequestBody) (*sdk.KubernetesCluster, error) {
+	resp, err := c.api.PauseClusterWithResponse(ctx, clusterID, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) ResumeCluster(ctx context.Context, clusterID sdk.ClusterId) (*sdk.KubernetesCluster, error) {
+	resp, err := c.api.ResumeClusterWithResponse(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) GetClusterPauseSchedule(ctx context.Context, clusterID sdk.ClusterId) (*sdk.PauseSchedule, error) {
+	resp, err := c.api.GetClusterPauseScheduleWithResponse(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) SetClusterPauseSchedule(ctx context.Context, clusterID sdk.ClusterId, req sdk.SetClusterPauseScheduleJSONRequestBody) (*sdk.PauseSchedule, error) {
+	resp, err := c.api.SetClusterPauseScheduleWithResponse(ctx, clusterID, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) DeleteClusterPauseSchedule(ctx context.Context, clusterID sdk.ClusterId) error {
+	resp, err := c.api.DeleteClusterPauseScheduleWithResponse(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) GetPolicies(ctx context.Context, clusterID sdk.ClusterId) (*sdk.PoliciesConfig, error) {
+	resp, err := c.api.GetPoliciesWithResponse(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) UpsertPolicies(ctx context.Context, clusterID sdk.ClusterId, req sdk.UpsertPoliciesJSONRequestBody) (*sdk.PoliciesConfig, error) {
+	resp, err := c.api.UpsertPoliciesWithResponse(ctx, clusterID, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
 func (c *client) GetClusterNode(ctx context.Context, clusterID sdk.ClusterId, nodeID string) (*sdk.Node, error) {
 	resp, err := c.api.GetClusterNodeWithResponse(ctx, clusterID, nodeID)
 	if err != nil {
@@ -109,26 +304,37 @@ func (c *client) GetClusterNode(ctx context.Context, clusterID sdk.ClusterId, no
 	return resp.JSON200, nil
 }
 
-func (c *client) AddClusterNode(ctx context.Context, clusterID sdk.ClusterId, node sdk.Node) error {
+func (c *client) AddClusterNode(ctx context.Context, clusterID sdk.ClusterId, node sdk.Node) (*sdk.AddNodeResult, error) {
 	resp, err := c.api.AddClusterNodeWithResponse(ctx, clusterID, sdk.AddClusterNodeJSONRequestBody(node))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if err := c.checkResponse(resp, err, http.StatusCreated); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return resp.JSON201, nil
 }
 
-func (c *client) DeleteClusterNode(ctx context.Context, clusterID sdk.ClusterId, nodeID string) error {
+func (c *client) DeleteClusterNode(ctx context.Context, clusterID sdk.ClusterId, nodeID string) (*sdk.DeleteNodeResult, error) {
 	resp, err := c.api.DeleteClusterNodeWithResponse(ctx, clusterID, nodeID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if err := c.checkResponse(resp, err, http.StatusCreated); err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return resp.JSON201, nil
+}
+
+func (c *client) UpdateNodeList(ctx context.Context, clusterID sdk.ClusterId, req sdk.UpdateNodeListJSONRequestBody) ([]sdk.Node, error) {
+	resp, err := c.api.UpdateNodeListWithResponse(ctx, clusterID, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200.Items, nil
 }
 
 func (c *client) SetupNodeSSH(ctx context.Context, clusterID sdk.ClusterId, nodeID string, req sdk.SetupNodeSshJSONRequestBody) error {
@@ -150,6 +356,14 @@ func (c *client) CloseNodeSSH(ctx context.Context, clusterID sdk.ClusterId, node
 	return c.checkResponse(resp, err, http.StatusOK)
 }
 
+func (c *client) InterruptClusterNode(ctx context.Context, clusterID sdk.ClusterId, nodeID string) error {
+	resp, err := c.api.InterruptClusterNodeWithResponse(ctx, clusterID, nodeID)
+	if err != nil {
+		return err
+	}
+	return c.checkResponse(resp, err, http.StatusOK)
+}
+
 func (c *client) ListClusterNodes(ctx context.Context, req sdk.ClusterId) ([]sdk.Node, error) {
 	resp, err := c.api.GetClusterNodesWithResponse(ctx, req)
 	if err != nil {
@@ -172,6 +386,17 @@ func (c *client) GetCluster(ctx context.Context, req sdk.ClusterId) (*sdk.Kubern
 	return resp.JSON200, nil
 }
 
+func (c *client) UpdateCluster(ctx context.Context, clusterID sdk.ClusterId, req sdk.UpdateClusterJSONRequestBody) (*sdk.KubernetesCluster, error) {
+	resp, err := c.api.UpdateClusterWithResponse(ctx, clusterID, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
 func (c *client) DeleteCluster(ctx context.Context, req sdk.ClusterId) error {
 	resp, err := c.api.DeleteClusterWithResponse(ctx, req)
 	if err != nil {
@@ -205,6 +430,17 @@ func (c *client) CreateNewCluster(ctx context.Context, body sdk.CreateNewCluster
 	return resp.JSON201, nil
 }
 
+func (c *client) PlanClusterPrice(ctx context.Context, body sdk.PlanClusterPriceJSONRequestBody) (*sdk.ClusterCostEstimate, error) {
+	resp, err := c.api.PlanClusterPriceWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
 func (c *client) ListRegions(ctx context.Context) ([]sdk.CastRegion, error) {
 	resp, err := c.api.ListRegionsWithResponse(ctx)
 	if err != nil {
@@ -216,6 +452,17 @@ func (c *client) ListRegions(ctx context.Context) ([]sdk.CastRegion, error) {
 	return resp.JSON200.Items, nil
 }
 
+func (c *client) ListInstanceTypes(ctx context.Context, req *sdk.ListInstanceTypesParams) ([]sdk.InstanceType, error) {
+	resp, err := c.api.ListInstanceTypesWithResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200.InstanceTypes, nil
+}
+
 func (c *client) ListCloudCredentials(ctx context.Context) ([]sdk.CloudCredentials, error) {
 	resp, err := c.api.ListCloudCredentialsWithResponse(ctx)
 	if err != nil {
@@ -227,6 +474,39 @@ func (c *client) ListCloudCredentials(ctx context.Context) ([]sdk.CloudCredentia
 	return resp.JSON200.Items, nil
 }
 
+func (c *client) GetCloudCredentials(ctx context.Context, credentialsID sdk.CredentialsId) (*sdk.CloudCredentials, error) {
+	resp, err := c.api.GetCloudCredentialsWithResponse(ctx, credentialsID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) CreateCloudCredentials(ctx context.Context, req sdk.CreateCloudCredentialsJSONRequestBody) (*sdk.CloudCredentials, error) {
+	resp, err := c.api.CreateCloudCredentialsWithResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return resp.JSON201, nil
+}
+
+func (c *client) DeleteCloudCredentials(ctx context.Context, credentialsID sdk.CredentialsId) error {
+	resp, err := c.api.DeleteCloudCredentialsWithResponse(ctx, credentialsID)
+	if err != nil {
+		return err
+	}
+	if err := c.checkResponse(resp, err, http.StatusNoContent); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (c *client) GetClusterKubeconfig(ctx context.Context, req sdk.ClusterId) ([]byte, error) {
 	resp, err := c.api.GetClusterKubeconfigWithResponse(ctx, req)
 	if err != nil {
@@ -260,6 +540,281 @@ func (c *client) ListAuthTokens(ctx context.Context) ([]sdk.AuthToken, error) {
 	return resp.JSON200.Items, nil
 }
 
+func (c *client) ListAddons(ctx context.Context, req *sdk.ListAddonsParams) ([]sdk.Addon, error) {
+	resp, err := c.api.ListAddonsWithResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200.List, nil
+}
+
+func (c *client) GetClusterAddons(ctx context.Context, clusterID sdk.ClusterId) ([]sdk.ClusterAddon, error) {
+	resp, err := c.api.GetClusterAddonsWithResponse(ctx, string(clusterID))
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200.List, nil
+}
+
+func (c *client) GetClusterAddon(ctx context.Context, clusterID sdk.ClusterId, repository, name string) (*sdk.ClusterAddon, error) {
+	resp, err := c.api.GetClusterAddonWithResponse(ctx, string(clusterID), repository, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) InstallClusterAddon(ctx context.Context, clusterID sdk.ClusterId, req sdk.InstallClusterAddonJSONRequestBody) (*sdk.ClusterAddon, error) {
+	resp, err := c.api.InstallClusterAddonWithResponse(ctx, string(clusterID), req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) UpdateClusterAddon(ctx context.Context, clusterID sdk.ClusterId, repository, name string, req sdk.UpdateClusterAddonJSONRequestBody) (*sdk.ClusterAddon, error) {
+	resp, err := c.api.UpdateClusterAddonWithResponse(ctx, string(clusterID), repository, name, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) DeleteClusterAddon(ctx context.Context, clusterID sdk.ClusterId, repository, name string) error {
+	resp, err := c.api.DeleteClusterAddonWithResponse(ctx, string(clusterID), repository, name)
+	if err != nil {
+		return err
+	}
+	if err := c.checkResponse(resp, err, http.StatusNoContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) ListAuditEvents(ctx context.Context, req *sdk.ListAuditEventsParams) (*sdk.AuditEventList, error) {
+	resp, err := c.api.ListAuditEventsWithResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) GetUsageReport(ctx context.Context, req *sdk.GetUsageReportParams) (*sdk.ResourceUsageReport, error) {
+	resp, err := c.api.GetUsageReportWithResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) GetAuthToken(ctx context.Context, tokenID sdk.AuthTokenId) (*sdk.AuthToken, error) {
+	resp, err := c.api.GetAuthTokenWithResponse(ctx, tokenID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) CreateAuthToken(ctx context.Context, req sdk.CreateAuthTokenJSONRequestBody) (*sdk.AuthTokenCreateResponse, error) {
+	resp, err := c.api.CreateAuthTokenWithResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return resp.JSON201, nil
+}
+
+func (c *client) UpdateAuthToken(ctx context.Context, tokenID sdk.AuthTokenId, req sdk.UpdateAuthTokenJSONRequestBody) (*sdk.AuthToken, error) {
+	resp, err := c.api.UpdateAuthTokenWithResponse(ctx, tokenID, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) DeleteAuthToken(ctx context.Context, tokenID sdk.AuthTokenId) error {
+	resp, err := c.api.DeleteAuthTokenWithResponse(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+	if err := c.checkResponse(resp, err, http.StatusNoContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) ListExternalClusters(ctx context.Context) ([]sdk.ExternalCluster, error) {
+	resp, err := c.api.ListExternalClustersWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200.Items, nil
+}
+
+func (c *client) RegisterExternalCluster(ctx context.Context, req sdk.RegisterExternalClusterJSONRequestBody) (*sdk.ExternalCluster, error) {
+	resp, err := c.api.RegisterExternalClusterWithResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) GetExternalCluster(ctx context.Context, clusterID string) (*sdk.ExternalCluster, error) {
+	resp, err := c.api.GetExternalClusterWithResponse(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) UpdateExternalCluster(ctx context.Context, clusterID string, req sdk.UpdateExternalClusterJSONRequestBody) (*sdk.ExternalCluster, error) {
+	resp, err := c.api.UpdateExternalClusterWithResponse(ctx, clusterID, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) DeleteExternalCluster(ctx context.Context, clusterID string) error {
+	resp, err := c.api.DeleteExternalClusterWithResponse(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	if err := c.checkResponse(resp, err, http.StatusNoContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (c *client) PauseExternalCluster(ctx context.Context, clusterID string) (*sdk.ExternalCluster, error) {
+	resp, err := c.api.PauseExternalClusterWithResponse(ctx, sdk.ClusterId(clusterID))
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) ResumeExternalCluster(ctx context.Context, clusterID string) (*sdk.ExternalCluster, error) {
+	resp, err := c.api.ResumeExternalClusterWithResponse(ctx, sdk.ClusterId(clusterID))
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) GetExternalClustersToken(ctx context.Context) (string, error) {
+	resp, err := c.api.GetExternalClustersTokenWithResponse(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return "", err
+	}
+	return resp.JSON200.Token, nil
+}
+
+func (c *client) ListExternalClusterNodes(ctx context.Context, clusterID string) ([]sdk.ExternalClusterNode, error) {
+	resp, err := c.api.ListExternalClusterNodesWithResponse(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200.Items, nil
+}
+
+func (c *client) AddExternalClusterNode(ctx context.Context, clusterID string, req sdk.AddExternalClusterNodeJSONRequestBody) (*sdk.ExternalClusterAddNodeResult, error) {
+	resp, err := c.api.AddExternalClusterNodeWithResponse(ctx, clusterID, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return resp.JSON201, nil
+}
+
+func (c *client) DeleteExternalClusterNode(ctx context.Context, clusterID, nodeID string) (*sdk.ExternalClusterDeleteNodeResult, error) {
+	resp, err := c.api.DeleteExternalClusterNodeWithResponse(ctx, clusterID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusCreated); err != nil {
+		return nil, err
+	}
+	return resp.JSON201, nil
+}
+
+func (c *client) GetExternalClusterOperation(ctx context.Context, operationID string) (*sdk.OperationResponse, error) {
+	resp, err := c.api.GetExternalClusterOperationWithResponse(ctx, operationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
+func (c *client) GetOperation(ctx context.Context, operationID string) (*sdk.OperationResponse, error) {
+	resp, err := c.api.GetOperationWithResponse(ctx, operationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(resp, err, http.StatusOK); err != nil {
+		return nil, err
+	}
+	return resp.JSON200, nil
+}
+
 func (c *client) GetMyPublicIP(ctx context.Context) (string, error) {
 	client := http.Client{Timeout: 30 * time.Second}
 	resp, err := client.Get(fmt.Sprintf("https://%s/my-public-ip", c.hostname))
@@ -286,7 +841,24 @@ func (c *client) checkResponse(resp sdk.Response, err error, expectedStatus int)
 		if resp.StatusCode() == http.StatusInternalServerError {
 			return errors.New("internal server error occurred, please try again")
 		}
-		return fmt.Errorf("expected status code %d, received: status=%d body=%s", expectedStatus, resp.StatusCode(), errBody)
+		return &StatusError{Expected: expectedStatus, Status: resp.StatusCode(), Body: errBody}
 	}
 	return nil
 }
+
+// StatusError is returned when API responds with unexpected status code.
+type StatusError struct {
+	Expected int
+	Status   int
+	Body     string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("expected status code %d, received: status=%d body=%s", e.Expected, e.Status, e.Body)
+}
+
+// IsNotFound returns true if API responded that requested resource doesn't exist.
+func IsNotFound(err error) bool {
+	var statusErr *StatusError
+	return errors.As(err, &statusErr) && statusErr.Status == http.StatusNotFound
+}
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -77,6 +78,12 @@ func redactSensitiveResponseBody(resp *http.Response, responseBody string) strin
 		func(req *http.Response) bool {
 			return resp.Request.Method == http.MethodGet && strings.Contains(resp.Request.URL.Path, "/kubeconfig")
 		},
+		func(req *http.Response) bool {
+			return resp.Request.Method == http.MethodPost && strings.HasSuffix(resp.Request.URL.Path, "/auth/tokens")
+		},
+		func(req *http.Response) bool {
+			return resp.Request.Method == http.MethodGet && strings.HasSuffix(resp.Request.URL.Path, "/me/external-clusters-token")
+		},
 	}
 
 	for _, shouldRedact := range responsesToRedact {
@@ -85,9 +92,50 @@ func redactSensitiveResponseBody(resp *http.Response, responseBody string) strin
 		}
 	}
 
+	// External clusters carry agent onboarding token, other fields are still useful for debugging.
+	if strings.Contains(resp.Request.URL.Path, "/kubernetes/external-clusters") {
+		return redactJSONField(responseBody, "token")
+	}
+
 	return responseBody
 }
 
+// redactJSONField redacts given field at any JSON body depth. Whole body is redacted if it's not valid JSON.
+func redactJSONField(body, field string) string {
+	if body == "" {
+		return body
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return redact(body)
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(redactJSONValue(v, field)); err != nil {
+		return redact(body)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func redactJSONValue(v interface{}, field string) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, item := range val {
+			if k == field {
+				val[k] = "<redacted>"
+				continue
+			}
+			val[k] = redactJSONValue(item, field)
+		}
+	case []interface{}:
+		for i, item := range val {
+			val[i] = redactJSONValue(item, field)
+		}
+	}
+	return v
+}
+
 func redact(text string) string {
 	redacted := "<redacted>"
 	if len(text) < 10 {
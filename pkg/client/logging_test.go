@@ -0,0 +1,44 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactSensitiveBody(t *testing.T) {
+	t.Run("redacts credentials request body", func(t *testing.T) {
+		req := &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "/v1/credentials"}}
+		body := `{"cloud":"aws","credentials":"{\"secretAccessKey\":\"secret\"}"}`
+		require.Equal(t, `{"cloud":"...<redacted>`, redactSensitiveRequestBody(req, body))
+	})
+
+	t.Run("keeps other request bodies", func(t *testing.T) {
+		req := &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "/v1/kubernetes/clusters"}}
+		require.Equal(t, `{"name":"test"}`, redactSensitiveRequestBody(req, `{"name":"test"}`))
+	})
+
+	t.Run("redacts created auth token response body", func(t *testing.T) {
+		resp := &http.Response{Request: &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "/v1/auth/tokens"}}}
+		require.Equal(t, `{"token":"...<redacted>`, redactSensitiveResponseBody(resp, `{"token":"secret-token"}`))
+	})
+
+	t.Run("redacts external clusters token response body", func(t *testing.T) {
+		resp := &http.Response{Request: &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "/v1/me/external-clusters-token"}}}
+		require.Equal(t, `{"token":"...<redacted>`, redactSensitiveResponseBody(resp, `{"token":"secret-token"}`))
+	})
+
+	t.Run("redacts external cluster token in register response body", func(t *testing.T) {
+		resp := &http.Response{Request: &http.Request{Method: http.MethodPost, URL: &url.URL{Path: "/v1/kubernetes/external-clusters"}}}
+		body := `{"id":"c1","name":"eks-prod","token":"agent-secret"}`
+		require.Equal(t, `{"id":"c1","name":"eks-prod","token":"<redacted>"}`, redactSensitiveResponseBody(resp, body))
+	})
+
+	t.Run("redacts external cluster tokens in list response body", func(t *testing.T) {
+		resp := &http.Response{Request: &http.Request{Method: http.MethodGet, URL: &url.URL{Path: "/v1/kubernetes/external-clusters"}}}
+		body := `{"items":[{"id":"c1","token":"agent-secret"}]}`
+		require.Equal(t, `{"items":[{"id":"c1","token":"<redacted>"}]}`, redactSensitiveResponseBody(resp, body))
+	})
+}
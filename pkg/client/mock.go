@@ -3,6 +3,8 @@ package client
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -93,6 +95,13 @@ func NewMock() Interface {
 				Name:        "eu-central",
 			},
 		},
+		instanceTypes: []sdk.InstanceType{
+			{Id: "i1", Provider: "aws", Region: "eu-central-1", InstanceType: "t3a.large", Vcpu: 2, Ram: 8192, Price: "0.0864"},
+			{Id: "i2", Provider: "aws", Region: "eu-central-1", InstanceType: "m5.large", Vcpu: 2, Ram: 8192, Price: "0.115"},
+			{Id: "i3", Provider: "aws", Region: "eu-central-1", InstanceType: "c5.xlarge", Vcpu: 4, Ram: 8192, Price: "0.194"},
+			{Id: "i4", Provider: "aws", Region: "eu-central-1", InstanceType: "m5.xlarge", Vcpu: 4, Ram: 16384, Price: "0.23"},
+			{Id: "i5", Provider: "gcp", Region: "europe-west3", InstanceType: "e2-standard-4", Vcpu: 4, Ram: 16384, Price: "0.1541"},
+		},
 		feedbackEvents: []sdk.KubernetesClusterFeedbackEvent{
 			{
 				CreatedAt: time.Date(2021, 1, 1, 12, 15, 5, 0, time.UTC),
@@ -101,6 +110,122 @@ func NewMock() Interface {
 				Severity:  "info",
 			},
 		},
+		addons: []sdk.Addon{
+			{
+				Id:               "a1",
+				Name:             "grafana",
+				Repository:       "official-addons",
+				Title:            "Grafana",
+				Version:          "6.1.0",
+				ShortDescription: stringPointer("Dashboards for cluster metrics"),
+			},
+			{
+				Id:               "a2",
+				Name:             "keda",
+				Repository:       "official-addons",
+				Title:            "KEDA",
+				Version:          "2.0.0",
+				ShortDescription: stringPointer("Event driven autoscaling"),
+			},
+		},
+		clusterAddons: map[string][]sdk.ClusterAddon{
+			c1: {},
+		},
+		tokens: []sdk.AuthToken{
+			{
+				Id:        "22222222-2222-2222-2222-222222222222",
+				Name:      "ci",
+				Active:    true,
+				CreatedAt: now,
+			},
+		},
+		auditEvents: []sdk.AuditEvent{
+			{
+				Id:          "e1",
+				EventType:   "clusterCreated",
+				InitiatedBy: sdk.AuditInitiator{Id: "u1", Name: "John Doe", Email: stringPointer("john@example.com")},
+				Time:        time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC),
+				Event:       map[string]interface{}{"cluster": map[string]interface{}{"id": c1, "name": "test-cluster-1"}},
+			},
+			{
+				Id:          "e2",
+				EventType:   "clusterDeleted",
+				InitiatedBy: sdk.AuditInitiator{Id: "u1", Name: "John Doe", Email: stringPointer("john@example.com")},
+				Time:        time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC),
+				Event:       map[string]interface{}{"cluster": map[string]interface{}{"id": c1, "name": "test-cluster-1"}},
+			},
+		},
+		external: []sdk.ExternalCluster{
+			{
+				Id:     "33333333-3333-3333-3333-333333333333",
+				Name:   "eks-prod",
+				Status: stringPointer("ready"),
+				Eks: &sdk.ExternalClusterEksConfiguration{
+					ClusterName: stringPointer("prod"),
+					Region:      stringPointer("eu-central-1"),
+				},
+			},
+		},
+		externalNodes: map[string][]sdk.ExternalClusterNode{
+			"33333333-3333-3333-3333-333333333333": {
+				{
+					Id:           "44444444-4444-4444-4444-444444444444",
+					Name:         "ip-10-0-1-10.eu-central-1.compute.internal",
+					Cloud:        "aws",
+					InstanceType: "m5.large",
+					Zone:         "eu-central-1a",
+				},
+			},
+		},
+		operations: map[string]sdk.OperationResponse{
+			"55555555-5555-5555-5555-555555555555": {
+				Id:         "55555555-5555-5555-5555-555555555555",
+				CreatedAt:  now,
+				Done:       true,
+				FinishedAt: &now,
+			},
+		},
+		gslbs: map[string]sdk.GSLBRequest{},
+		auditLogs: map[string][]sdk.AuditLogEvent{
+			c1: {
+				{
+					Id:        "66666666-6666-6666-6666-666666666661",
+					CreatedAt: time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC),
+					Operation: "clusterCreated",
+					Metadata: map[string]interface{}{
+						"name":   "test-cluster-1",
+						"region": "eu-central",
+						"user":   map[string]interface{}{"email": "admin@example.com"},
+					},
+				},
+				{
+					Id:        "66666666-6666-6666-6666-666666666662",
+					CreatedAt: time.Date(2021, 1, 2, 12, 0, 0, 0, time.UTC),
+					Operation: "nodeAdded",
+					Metadata: map[string]interface{}{
+						"node":   map[string]interface{}{"cloud": "aws", "role": "master"},
+						"labels": []interface{}{"a", "b"},
+					},
+				},
+			},
+		},
+		policies: map[string]sdk.PoliciesConfig{
+			c1: {
+				Enabled: true,
+				ClusterLimits: sdk.ClusterLimitsPolicy{
+					Enabled: true,
+					Cpu:     sdk.ClusterLimitsCpu{MinCores: 2, MaxCores: 20},
+				},
+				SpotInstances: sdk.SpotInstances{
+					Enabled: true,
+					Clouds:  []string{"aws"},
+				},
+				UnschedulablePods: sdk.UnschedulablePodsPolicy{
+					Enabled:  true,
+					Headroom: sdk.Headroom{CpuPercentage: 10, MemoryPercentage: 10},
+				},
+			},
+		},
 	}
 }
 
@@ -113,34 +238,193 @@ type mockClient struct {
 	clusters       map[string]sdk.KubernetesCluster
 	nodes          map[string]map[string]sdk.Node
 	regions        []sdk.CastRegion
+	instanceTypes  []sdk.InstanceType
 	tokens         []sdk.AuthToken
 	feedbackEvents []sdk.KubernetesClusterFeedbackEvent
+	addons         []sdk.Addon
+	clusterAddons  map[string][]sdk.ClusterAddon
+	auditEvents    []sdk.AuditEvent
+	policies       map[string]sdk.PoliciesConfig
+	external       []sdk.ExternalCluster
+	externalNodes  map[string][]sdk.ExternalClusterNode
+	operations     map[string]sdk.OperationResponse
+	gslbs          map[string]sdk.GSLBRequest
+	auditLogs      map[string][]sdk.AuditLogEvent
 }
 
 func (m *mockClient) GetClusterFeedbackEvents(ctx context.Context, clusterID sdk.ClusterId) ([]sdk.KubernetesClusterFeedbackEvent, error) {
 	return []sdk.KubernetesClusterFeedbackEvent{}, nil
 }
 
-func (m *mockClient) TriggerClusterReconcile(ctx context.Context, clusterID sdk.ClusterId) error {
+func (m *mockClient) GetClusterHealth(ctx context.Context, clusterID sdk.ClusterId) (*sdk.ClusterHealth, error) {
+	nodes, ok := m.nodes[string(clusterID)]
+	if !ok {
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	health := &sdk.ClusterHealth{
+		Cilium:     sdk.ClusterHealthCilium{State: "healthy"},
+		Kubernetes: sdk.ClusterHealthKubernetes{State: "healthy"},
+		Nodes:      sdk.ClusterHealthNodes{Items: []sdk.ClusterHealthNode{}},
+	}
+	for _, node := range nodes {
+		item := sdk.ClusterHealthNode{State: "healthy"}
+		if node.Name != nil {
+			item.Name = *node.Name
+		}
+		if node.Network != nil {
+			item.HostIp = node.Network.PrivateIp
+		}
+		health.Nodes.Items = append(health.Nodes.Items, item)
+	}
+	return health, nil
+}
+
+func (m *mockClient) GetClusterMetrics(ctx context.Context, clusterID sdk.ClusterId, metricsType sdk.MetricsType) (*sdk.ClusterMetrics, error) {
+	nodes, ok := m.nodes[string(clusterID)]
+	if !ok {
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	ts := float64(time.Now().Unix())
+	result := []map[string]interface{}{}
+	for _, node := range nodes {
+		result = append(result, map[string]interface{}{
+			"metric": map[string]interface{}{"node": *node.Name},
+			"values": []interface{}{
+				[]interface{}{ts - 120, "0.5"},
+				[]interface{}{ts - 60, "1"},
+				[]interface{}{ts, "0.75"},
+			},
+		})
+	}
+	return &sdk.ClusterMetrics{
+		Status: stringPointer("success"),
+		Data: &struct {
+			Result     *[]map[string]interface{} `json:"result,omitempty"`
+			ResultType *string                   `json:"resultType,omitempty"`
+		}{
+			Result:     &result,
+			ResultType: stringPointer("matrix"),
+		},
+	}, nil
+}
+
+func (m *mockClient) ArchiveCluster(ctx context.Context, clusterID sdk.ClusterId) error {
+	c, ok := m.clusters[string(clusterID)]
+	if !ok {
+		return fmt.Errorf("cluster %s not found", clusterID)
+	}
+	if c.Status != "deleted" {
+		return fmt.Errorf("cluster %s is not deleted", clusterID)
+	}
+	delete(m.clusters, string(clusterID))
+	delete(m.nodes, string(clusterID))
 	return nil
 }
 
-func (m *mockClient) AddClusterNode(ctx context.Context, clusterID sdk.ClusterId, node sdk.Node) error {
+func (m *mockClient) GetClusterAuditLog(ctx context.Context, clusterID sdk.ClusterId) ([]sdk.AuditLogEvent, error) {
+	if _, ok := m.clusters[string(clusterID)]; !ok {
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	return m.auditLogs[string(clusterID)], nil
+}
+
+func (m *mockClient) GetClusterIngressController(ctx context.Context, clusterID sdk.ClusterId) (*sdk.KubernetesIngressController, error) {
 	nodes, ok := m.nodes[string(clusterID)]
 	if !ok {
-		return fmt.Errorf("cluster not found, id=%s", clusterID)
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	res := &sdk.KubernetesIngressController{Ports: []int{80, 443}}
+	for _, node := range nodes {
+		if node.Role == "master" && node.Network != nil {
+			res.LoadBalancers = append(res.LoadBalancers, sdk.IngressLoadBalancer{Address: node.Network.PublicIp, Type: string(node.Cloud)})
+		}
 	}
-	nodes[uuid.New().String()] = node
+	sort.Slice(res.LoadBalancers, func(i, j int) bool {
+		return res.LoadBalancers[i].Address < res.LoadBalancers[j].Address
+	})
+	return res, nil
+}
+
+func gslbKey(clusterID, namespace, service string) string {
+	return fmt.Sprintf("%s/%s/%s", clusterID, namespace, service)
+}
+
+func (m *mockClient) CreateOrUpdateGslb(ctx context.Context, req sdk.CreateOrUpdateGslbJSONRequestBody) (*sdk.GSLBResponse, error) {
+	if _, ok := m.clusters[req.ClusterId]; !ok {
+		return nil, fmt.Errorf("cluster %s not found", req.ClusterId)
+	}
+	m.gslbs[gslbKey(req.ClusterId, req.ServiceNamespace, req.ServiceName)] = sdk.GSLBRequest(req)
+	return &sdk.GSLBResponse{Dns: fmt.Sprintf("%s-%s-%s.gslb.cast.ai", req.ServiceName, req.ServiceNamespace, req.ClusterId[:8])}, nil
+}
+
+func (m *mockClient) DeleteGslb(ctx context.Context, req sdk.DeleteGslbJSONRequestBody) error {
+	key := gslbKey(req.ClusterId, req.ServiceNamespace, req.ServiceName)
+	if _, ok := m.gslbs[key]; !ok {
+		return fmt.Errorf("gslb for service %s/%s not found", req.ServiceNamespace, req.ServiceName)
+	}
+	delete(m.gslbs, key)
 	return nil
 }
 
-func (m *mockClient) DeleteClusterNode(ctx context.Context, clusterID sdk.ClusterId, nodeID string) error {
+func (m *mockClient) TriggerClusterReconcile(ctx context.Context, clusterID sdk.ClusterId) error {
+	c, ok := m.clusters[string(clusterID)]
+	if !ok {
+		return fmt.Errorf("cluster %s not found", clusterID)
+	}
+	now := time.Now()
+	c.ReconciledAt = &now
+	m.clusters[string(clusterID)] = c
+	return nil
+}
+
+func (m *mockClient) AddClusterNode(ctx context.Context, clusterID sdk.ClusterId, node sdk.Node) (*sdk.AddNodeResult, error) {
 	nodes, ok := m.nodes[string(clusterID)]
 	if !ok {
-		return fmt.Errorf("cluster not found, id=%s", clusterID)
+		return nil, fmt.Errorf("cluster not found, id=%s", clusterID)
+	}
+	id := uuid.New().String()
+	now := time.Now()
+	node.Id = stringPointer(id)
+	node.Name = stringPointer(fmt.Sprintf("%s-%s-%s", node.Cloud, node.Role, id[:8]))
+	node.State = &sdk.NodeState{Phase: stringPointer("creating")}
+	node.CreatedAt = &now
+	nodes[id] = node
+	return &sdk.AddNodeResult{NodeId: id, OperationId: m.addDoneOperation()}, nil
+}
+
+func (m *mockClient) DeleteClusterNode(ctx context.Context, clusterID sdk.ClusterId, nodeID string) (*sdk.DeleteNodeResult, error) {
+	nodes, ok := m.nodes[string(clusterID)]
+	if !ok {
+		return nil, fmt.Errorf("cluster not found, id=%s", clusterID)
 	}
 	delete(nodes, nodeID)
-	return nil
+	return &sdk.DeleteNodeResult{OperationId: m.addDoneOperation()}, nil
+}
+
+func (m *mockClient) UpdateNodeList(ctx context.Context, clusterID sdk.ClusterId, req sdk.UpdateNodeListJSONRequestBody) ([]sdk.Node, error) {
+	nodes, ok := m.nodes[string(clusterID)]
+	if !ok {
+		return nil, fmt.Errorf("cluster not found, id=%s", clusterID)
+	}
+	if req.Delete != nil {
+		for _, node := range *req.Delete {
+			delete(nodes, node.Id)
+		}
+	}
+	var added []sdk.Node
+	if req.Add != nil {
+		now := time.Now()
+		for _, node := range *req.Add {
+			id := uuid.New().String()
+			node.Id = stringPointer(id)
+			node.Name = stringPointer(fmt.Sprintf("%s-%s-%s", node.Cloud, node.Role, id[:8]))
+			node.State = &sdk.NodeState{Phase: stringPointer("creating")}
+			node.CreatedAt = &now
+			nodes[id] = node
+			added = append(added, node)
+		}
+	}
+	return added, nil
 }
 
 func (m *mockClient) CloseNodeSSH(ctx context.Context, clusterID sdk.ClusterId, nodeID string) error {
@@ -163,6 +447,28 @@ func (m *mockClient) SetupNodeSSH(ctx context.Context, clusterID sdk.ClusterId,
 	return nil
 }
 
+func (m *mockClient) InterruptClusterNode(ctx context.Context, clusterID sdk.ClusterId, nodeID string) error {
+	nodes, ok := m.nodes[string(clusterID)]
+	if !ok {
+		return fmt.Errorf("cluster not found, id=%s", clusterID)
+	}
+	node, ok := nodes[nodeID]
+	if !ok {
+		return fmt.Errorf("node not found, id=%s", nodeID)
+	}
+	delete(nodes, nodeID)
+
+	// Interrupted node is replaced immediately.
+	id := uuid.New().String()
+	now := time.Now()
+	node.Id = stringPointer(id)
+	node.Name = stringPointer(fmt.Sprintf("%s-%s-%s", node.Cloud, node.Role, id[:8]))
+	node.State = &sdk.NodeState{Phase: stringPointer("ready")}
+	node.CreatedAt = &now
+	nodes[id] = node
+	return nil
+}
+
 func (m *mockClient) ListClusterNodes(ctx context.Context, req sdk.ClusterId) ([]sdk.Node, error) {
 	var res []sdk.Node
 	for _, node := range m.nodes[string(req)] {
@@ -178,12 +484,96 @@ func (m *mockClient) GetCluster(ctx context.Context, req sdk.ClusterId) (*sdk.Ku
 	return nil, fmt.Errorf("cluster %s not found", req)
 }
 
+func (m *mockClient) UpdateCluster(ctx context.Context, clusterID sdk.ClusterId, req sdk.UpdateClusterJSONRequestBody) (*sdk.KubernetesCluster, error) {
+	c, ok := m.clusters[string(clusterID)]
+	if !ok {
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	c.CloudCredentialsIDs = req.CloudCredentialsIDs
+	c.Network = req.Network
+	m.clusters[string(clusterID)] = c
+	return &c, nil
+}
+
+func (m *mockClient) PauseCluster(ctx context.Context, clusterID sdk.ClusterId, req sdk.PauseClusterJSONRequestBody) (*sdk.KubernetesCluster, error) {
+	c, ok := m.clusters[string(clusterID)]
+	if !ok {
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	c.Status = "paused"
+	c.PausedNotes = req.Notes
+	m.clusters[string(clusterID)] = c
+	return &c, nil
+}
+
+func (m *mockClient) ResumeCluster(ctx context.Context, clusterID sdk.ClusterId) (*sdk.KubernetesCluster, error) {
+	c, ok := m.clusters[string(clusterID)]
+	if !ok {
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	c.Status = "ready"
+	c.PausedNotes = nil
+	m.clusters[string(clusterID)] = c
+	return &c, nil
+}
+
+func (m *mockClient) GetClusterPauseSchedule(ctx context.Context, clusterID sdk.ClusterId) (*sdk.PauseSchedule, error) {
+	c, ok := m.clusters[string(clusterID)]
+	if !ok {
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	if c.PauseSchedule == nil {
+		return nil, fmt.Errorf("pause schedule not found for cluster %s", clusterID)
+	}
+	return c.PauseSchedule, nil
+}
+
+func (m *mockClient) SetClusterPauseSchedule(ctx context.Context, clusterID sdk.ClusterId, req sdk.SetClusterPauseScheduleJSONRequestBody) (*sdk.PauseSchedule, error) {
+	c, ok := m.clusters[string(clusterID)]
+	if !ok {
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	schedule := sdk.PauseSchedule(req)
+	c.PauseSchedule = &schedule
+	m.clusters[string(clusterID)] = c
+	return &schedule, nil
+}
+
+func (m *mockClient) DeleteClusterPauseSchedule(ctx context.Context, clusterID sdk.ClusterId) error {
+	c, ok := m.clusters[string(clusterID)]
+	if !ok {
+		return fmt.Errorf("cluster %s not found", clusterID)
+	}
+	c.PauseSchedule = nil
+	m.clusters[string(clusterID)] = c
+	return nil
+}
+
+func (m *mockClient) GetPolicies(ctx context.Context, clusterID sdk.ClusterId) (*sdk.PoliciesConfig, error) {
+	if _, ok := m.clusters[string(clusterID)]; !ok {
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	policies := m.policies[string(clusterID)]
+	return &policies, nil
+}
+
+func (m *mockClient) UpsertPolicies(ctx context.Context, clusterID sdk.ClusterId, req sdk.UpsertPoliciesJSONRequestBody) (*sdk.PoliciesConfig, error) {
+	if _, ok := m.clusters[string(clusterID)]; !ok {
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	policies := sdk.PoliciesConfig(req)
+	m.policies[string(clusterID)] = policies
+	return &policies, nil
+}
+
 func (m *mockClient) DeleteCluster(ctx context.Context, req sdk.ClusterId) error {
-	if _, ok := m.clusters[string(req)]; ok {
-		delete(m.clusters, string(req))
-		return nil
+	c, ok := m.clusters[string(req)]
+	if !ok {
+		return fmt.Errorf("cluster %s not found", req)
 	}
-	return fmt.Errorf("cluster %s not found", req)
+	c.Status = "deleted"
+	m.clusters[string(req)] = c
+	return nil
 }
 
 func (m *mockClient) FeedbackEvents(ctx context.Context, req sdk.ClusterId) ([]sdk.KubernetesClusterFeedbackEvent, error) {
@@ -191,7 +581,9 @@ func (m *mockClient) FeedbackEvents(ctx context.Context, req sdk.ClusterId) ([]s
 }
 
 func (m *mockClient) CreateNewCluster(ctx context.Context, req sdk.CreateNewClusterJSONRequestBody) (*sdk.KubernetesCluster, error) {
+	now := time.Now()
 	newCluster := sdk.KubernetesCluster{
+		CreatedAt:           &now,
 		Addons:              req.Addons,
 		CloudCredentialsIDs: req.CloudCredentialsIDs,
 		Id:                  uuid.New().String(),
@@ -205,17 +597,101 @@ func (m *mockClient) CreateNewCluster(ctx context.Context, req sdk.CreateNewClus
 		Status: "ready",
 	}
 	m.clusters[newCluster.Id] = newCluster
+	m.nodes[newCluster.Id] = map[string]sdk.Node{}
 	return &newCluster, nil
 }
 
+func (m *mockClient) PlanClusterPrice(ctx context.Context, req sdk.PlanClusterPriceJSONRequestBody) (*sdk.ClusterCostEstimate, error) {
+	price := func(hourly, monthly string) sdk.EstimatedPriceAmount {
+		return sdk.EstimatedPriceAmount{
+			CurrencyCode: stringPointer("USD"),
+			Hourly:       stringPointer(hourly),
+			Monthly:      stringPointer(monthly),
+		}
+	}
+	total := price("0.1000", "72.00")
+	perType := &sdk.CostsPerTypeEstimate{}
+	masterCount, workerCount := 1, 1
+	masterPrice, workerPrice := price("0.0600", "43.20"), price("0.0400", "28.80")
+	perType.Set("master", sdk.EstimatedComponentTypePrice{Price: &masterPrice, UnitCount: &masterCount})
+	perType.Set("worker", sdk.EstimatedComponentTypePrice{Price: &workerPrice, UnitCount: &workerCount})
+	return &sdk.ClusterCostEstimate{
+		PerCloud: &sdk.CostsPerProviderEstimate{
+			Details: &[]sdk.EstimatedComponentPrice{
+				{
+					Id:        "aws",
+					Name:      "AWS",
+					UnitCount: 2,
+					UnitPrice: price("0.0500", "36.00"),
+					FullPrice: total,
+				},
+			},
+			TotalHourly:  "0.1000",
+			TotalMonthly: stringPointer("72.00"),
+		},
+		PerType: perType,
+		Total:   &total,
+	}, nil
+}
+
 func (m *mockClient) ListRegions(ctx context.Context) ([]sdk.CastRegion, error) {
 	return m.regions, nil
 }
 
+func (m *mockClient) ListInstanceTypes(ctx context.Context, req *sdk.ListInstanceTypesParams) ([]sdk.InstanceType, error) {
+	matches := func(filter *[]string, v string) bool {
+		if filter == nil {
+			return true
+		}
+		for _, f := range *filter {
+			if f == v {
+				return true
+			}
+		}
+		return false
+	}
+	var res []sdk.InstanceType
+	for _, item := range m.instanceTypes {
+		if matches(req.Providers, item.Provider) && matches(req.Regions, item.Region) && matches(req.InstanceTypes, item.InstanceType) {
+			res = append(res, item)
+		}
+	}
+	return res, nil
+}
+
 func (m *mockClient) ListCloudCredentials(ctx context.Context) ([]sdk.CloudCredentials, error) {
 	return m.credentials, nil
 }
 
+func (m *mockClient) GetCloudCredentials(ctx context.Context, credentialsID sdk.CredentialsId) (*sdk.CloudCredentials, error) {
+	for _, item := range m.credentials {
+		if item.Id == string(credentialsID) {
+			return &item, nil
+		}
+	}
+	return nil, fmt.Errorf("credentials %s not found", credentialsID)
+}
+
+func (m *mockClient) CreateCloudCredentials(ctx context.Context, req sdk.CreateCloudCredentialsJSONRequestBody) (*sdk.CloudCredentials, error) {
+	item := sdk.CloudCredentials{
+		Cloud: req.Cloud,
+		Id:    uuid.New().String(),
+		Name:  req.Name,
+	}
+	m.credentials = append(m.credentials, item)
+	return &item, nil
+}
+
+func (m *mockClient) DeleteCloudCredentials(ctx context.Context, credentialsID sdk.CredentialsId) error {
+	for i, item := range m.credentials {
+		if item.Id == string(credentialsID) {
+			m.credentials = append(m.credentials[:i], m.credentials[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("credentials %s not found", credentialsID)
+}
+
 func (m *mockClient) GetClusterKubeconfig(ctx context.Context, req sdk.ClusterId) ([]byte, error) {
 	config := `
 apiVersion: v1
@@ -237,3 +713,288 @@ func (m *mockClient) ListKubernetesClusters(ctx context.Context, req *sdk.ListKu
 func (m *mockClient) ListAuthTokens(ctx context.Context) ([]sdk.AuthToken, error) {
 	return m.tokens, nil
 }
+
+func (m *mockClient) GetAuthToken(ctx context.Context, tokenID sdk.AuthTokenId) (*sdk.AuthToken, error) {
+	for _, token := range m.tokens {
+		if token.Id == string(tokenID) {
+			return &token, nil
+		}
+	}
+	return nil, fmt.Errorf("token %s not found", tokenID)
+}
+
+func (m *mockClient) CreateAuthToken(ctx context.Context, req sdk.CreateAuthTokenJSONRequestBody) (*sdk.AuthTokenCreateResponse, error) {
+	token := sdk.AuthToken{
+		Id:        uuid.New().String(),
+		Name:      req.Name,
+		Active:    true,
+		CreatedAt: time.Now(),
+	}
+	m.tokens = append(m.tokens, token)
+	return &sdk.AuthTokenCreateResponse{
+		Active:    token.Active,
+		CreatedAt: token.CreatedAt,
+		Id:        token.Id,
+		Name:      token.Name,
+		Token:     "secret-token",
+	}, nil
+}
+
+func (m *mockClient) UpdateAuthToken(ctx context.Context, tokenID sdk.AuthTokenId, req sdk.UpdateAuthTokenJSONRequestBody) (*sdk.AuthToken, error) {
+	for i, token := range m.tokens {
+		if token.Id == string(tokenID) {
+			m.tokens[i].Active = req.Active
+			return &m.tokens[i], nil
+		}
+	}
+	return nil, fmt.Errorf("token %s not found", tokenID)
+}
+
+func (m *mockClient) DeleteAuthToken(ctx context.Context, tokenID sdk.AuthTokenId) error {
+	for i, token := range m.tokens {
+		if token.Id == string(tokenID) {
+			m.tokens = append(m.tokens[:i], m.tokens[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("token %s not found", tokenID)
+}
+
+func (m *mockClient) ListAddons(ctx context.Context, req *sdk.ListAddonsParams) ([]sdk.Addon, error) {
+	if req == nil || req.Names == nil {
+		return m.addons, nil
+	}
+	var res []sdk.Addon
+	for _, addon := range m.addons {
+		for _, name := range *req.Names {
+			if addon.Name == name {
+				res = append(res, addon)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (m *mockClient) GetClusterAddons(ctx context.Context, clusterID sdk.ClusterId) ([]sdk.ClusterAddon, error) {
+	addons, ok := m.clusterAddons[string(clusterID)]
+	if !ok {
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	return addons, nil
+}
+
+func (m *mockClient) GetClusterAddon(ctx context.Context, clusterID sdk.ClusterId, repository, name string) (*sdk.ClusterAddon, error) {
+	for _, item := range m.clusterAddons[string(clusterID)] {
+		if item.Addon.Repository == repository && item.Addon.Name == name {
+			return &item, nil
+		}
+	}
+	return nil, fmt.Errorf("addon %s/%s not found", repository, name)
+}
+
+func (m *mockClient) InstallClusterAddon(ctx context.Context, clusterID sdk.ClusterId, req sdk.InstallClusterAddonJSONRequestBody) (*sdk.ClusterAddon, error) {
+	if _, ok := m.clusterAddons[string(clusterID)]; !ok {
+		return nil, fmt.Errorf("cluster %s not found", clusterID)
+	}
+	item := sdk.ClusterAddon{
+		Addon: sdk.Addon{
+			Id:         uuid.New().String(),
+			Name:       req.Name,
+			Repository: req.Repository,
+			Version:    req.Version,
+		},
+		Status: "deployed",
+	}
+	m.clusterAddons[string(clusterID)] = append(m.clusterAddons[string(clusterID)], item)
+	return &item, nil
+}
+
+func (m *mockClient) UpdateClusterAddon(ctx context.Context, clusterID sdk.ClusterId, repository, name string, req sdk.UpdateClusterAddonJSONRequestBody) (*sdk.ClusterAddon, error) {
+	addons := m.clusterAddons[string(clusterID)]
+	for i, item := range addons {
+		if item.Addon.Repository == repository && item.Addon.Name == name {
+			if req.Version != nil {
+				addons[i].Addon.Version = *req.Version
+			}
+			return &addons[i], nil
+		}
+	}
+	return nil, fmt.Errorf("addon %s/%s not found", repository, name)
+}
+
+func (m *mockClient) DeleteClusterAddon(ctx context.Context, clusterID sdk.ClusterId, repository, name string) error {
+	addons := m.clusterAddons[string(clusterID)]
+	for i, item := range addons {
+		if item.Addon.Repository == repository && item.Addon.Name == name {
+			m.clusterAddons[string(clusterID)] = append(addons[:i], addons[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("addon %s/%s not found", repository, name)
+}
+
+func (m *mockClient) ListAuditEvents(ctx context.Context, req *sdk.ListAuditEventsParams) (*sdk.AuditEventList, error) {
+	from, limit := 0, len(m.auditEvents)
+	if req.Cursor != nil && *req.Cursor != "" {
+		v, err := strconv.Atoi(string(*req.Cursor))
+		if err != nil {
+			return nil, fmt.Errorf("invalid cursor %q", *req.Cursor)
+		}
+		from = v
+	}
+	if req.Limit != nil {
+		limit = int(*req.Limit)
+	}
+	to := from + limit
+	if to > len(m.auditEvents) {
+		to = len(m.auditEvents)
+	}
+	res := &sdk.AuditEventList{Items: m.auditEvents[from:to]}
+	if to < len(m.auditEvents) {
+		res.NextCursor = strconv.Itoa(to)
+	}
+	return res, nil
+}
+
+func (m *mockClient) GetUsageReport(ctx context.Context, req *sdk.GetUsageReportParams) (*sdk.ResourceUsageReport, error) {
+	if req.ClusterId != nil {
+		if _, ok := m.clusters[string(*req.ClusterId)]; !ok {
+			return nil, fmt.Errorf("cluster %s not found", *req.ClusterId)
+		}
+	}
+	return &sdk.ResourceUsageReport{
+		FromDate: "2021-01-01T00:00:00Z",
+		ToDate:   "2021-01-03T00:00:00Z",
+		Dates: []sdk.ResourceUsage{
+			{From: "2021-01-01T00:00:00Z", To: "2021-01-02T00:00:00Z", Cpu: 48, Memory: 98304},
+			{From: "2021-01-02T00:00:00Z", To: "2021-01-03T00:00:00Z", Cpu: 24, Memory: 49152},
+		},
+	}, nil
+}
+
+func (m *mockClient) ListExternalClusters(ctx context.Context) ([]sdk.ExternalCluster, error) {
+	return m.external, nil
+}
+
+func (m *mockClient) RegisterExternalCluster(ctx context.Context, req sdk.RegisterExternalClusterJSONRequestBody) (*sdk.ExternalCluster, error) {
+	cluster := sdk.ExternalCluster(req)
+	if cluster.Id == "" {
+		cluster.Id = uuid.New().String()
+	}
+	cluster.Status = stringPointer("connecting")
+	cluster.Token = stringPointer("external-token")
+	m.external = append(m.external, cluster)
+	return &cluster, nil
+}
+
+func (m *mockClient) GetExternalCluster(ctx context.Context, clusterID string) (*sdk.ExternalCluster, error) {
+	for _, item := range m.external {
+		if item.Id == clusterID {
+			return &item, nil
+		}
+	}
+	return nil, fmt.Errorf("external cluster %s not found", clusterID)
+}
+
+func (m *mockClient) UpdateExternalCluster(ctx context.Context, clusterID string, req sdk.UpdateExternalClusterJSONRequestBody) (*sdk.ExternalCluster, error) {
+	return m.GetExternalCluster(ctx, clusterID)
+}
+
+func (m *mockClient) DeleteExternalCluster(ctx context.Context, clusterID string) error {
+	for i, item := range m.external {
+		if item.Id == clusterID {
+			m.external = append(m.external[:i], m.external[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("external cluster %s not found", clusterID)
+}
+
+func (m *mockClient) PauseExternalCluster(ctx context.Context, clusterID string) (*sdk.ExternalCluster, error) {
+	return m.setExternalClusterStatus(clusterID, "paused")
+}
+
+func (m *mockClient) ResumeExternalCluster(ctx context.Context, clusterID string) (*sdk.ExternalCluster, error) {
+	return m.setExternalClusterStatus(clusterID, "ready")
+}
+
+func (m *mockClient) setExternalClusterStatus(clusterID, status string) (*sdk.ExternalCluster, error) {
+	for i, item := range m.external {
+		if item.Id == clusterID {
+			m.external[i].Status = stringPointer(status)
+			return &m.external[i], nil
+		}
+	}
+	return nil, fmt.Errorf("external cluster %s not found", clusterID)
+}
+
+func (m *mockClient) GetExternalClustersToken(ctx context.Context) (string, error) {
+	return "external-token", nil
+}
+
+func (m *mockClient) ListExternalClusterNodes(ctx context.Context, clusterID string) ([]sdk.ExternalClusterNode, error) {
+	if _, err := m.GetExternalCluster(ctx, clusterID); err != nil {
+		return nil, err
+	}
+	return m.externalNodes[clusterID], nil
+}
+
+func (m *mockClient) AddExternalClusterNode(ctx context.Context, clusterID string, req sdk.AddExternalClusterNodeJSONRequestBody) (*sdk.ExternalClusterAddNodeResult, error) {
+	if _, err := m.GetExternalCluster(ctx, clusterID); err != nil {
+		return nil, err
+	}
+	node := sdk.ExternalClusterNode{
+		Id:           uuid.New().String(),
+		Cloud:        "aws",
+		InstanceType: req.InstanceType,
+	}
+	node.Name = "node-" + node.Id[:8]
+	if req.Zone != nil {
+		node.Zone = *req.Zone
+	}
+	if req.SpotConfig != nil {
+		node.SpotConfig = *req.SpotConfig
+	}
+	m.externalNodes[clusterID] = append(m.externalNodes[clusterID], node)
+	return &sdk.ExternalClusterAddNodeResult{NodeId: node.Id, OperationId: m.addDoneOperation()}, nil
+}
+
+func (m *mockClient) DeleteExternalClusterNode(ctx context.Context, clusterID, nodeID string) (*sdk.ExternalClusterDeleteNodeResult, error) {
+	nodes := m.externalNodes[clusterID]
+	for i, node := range nodes {
+		if node.Id == nodeID {
+			m.externalNodes[clusterID] = append(nodes[:i], nodes[i+1:]...)
+			return &sdk.ExternalClusterDeleteNodeResult{OperationId: m.addDoneOperation()}, nil
+		}
+	}
+	return nil, fmt.Errorf("node %s not found", nodeID)
+}
+
+func (m *mockClient) GetExternalClusterOperation(ctx context.Context, operationID string) (*sdk.OperationResponse, error) {
+	op, ok := m.operations[operationID]
+	if !ok {
+		return nil, fmt.Errorf("operation %s not found", operationID)
+	}
+	return &op, nil
+}
+
+func (m *mockClient) GetOperation(ctx context.Context, operationID string) (*sdk.OperationResponse, error) {
+	op, ok := m.operations[operationID]
+	if !ok {
+		return nil, fmt.Errorf("operation %s not found", operationID)
+	}
+	return &op, nil
+}
+
+// addDoneOperation adds already finished operation and returns its ID.
+func (m *mockClient) addDoneOperation() string {
+	now := time.Now()
+	op := sdk.OperationResponse{
+		Id:         uuid.New().String(),
+		CreatedAt:  now,
+		Done:       true,
+		FinishedAt: &now,
+	}
+	m.operations[op.Id] = op
+	return op.Id
+}
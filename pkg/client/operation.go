@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+// OperationGetter returns current state of long running operation, eg. Interface.GetOperation.
+type OperationGetter func(ctx context.Context, operationID string) (*sdk.OperationResponse, error)
+
+// OperationError is returned when long running operation finishes with an error.
+type OperationError struct {
+	OperationID string
+	Reason      string
+	Details     string
+}
+
+func (e *OperationError) Error() string {
+	return fmt.Sprintf("operation %s failed: %s: %s", e.OperationID, e.Reason, e.Details)
+}
+
+var errOperationInProgress = errors.New("operation is in progress")
+
+// newOperationBackOff is used to poll operation state. It doesn't limit elapsed time, use context deadline instead.
+var newOperationBackOff = func() backoff.BackOff {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = 2 * time.Second
+	b.MaxInterval = 15 * time.Second
+	b.MaxElapsedTime = 0
+	return b
+}
+
+// operationMaxErrors is max number of consecutive transient operation get errors tolerated while waiting.
+const operationMaxErrors = 5
+
+// WaitOperation polls operation state with exponential backoff until it's done or context is done. Transient
+// operation get errors are retried, client errors like not found are returned immediately.
+// Returned error is *OperationError if operation finished with an error.
+func WaitOperation(ctx context.Context, get OperationGetter, operationID string) (*sdk.OperationResponse, error) {
+	var op *sdk.OperationResponse
+	errCount := 0
+	err := backoff.Retry(func() error {
+		res, err := get(ctx, operationID)
+		if err != nil {
+			errCount++
+			if errCount >= operationMaxErrors || isClientError(err) {
+				return backoff.Permanent(err)
+			}
+			return err
+		}
+		errCount = 0
+		op = res
+		if !op.Done {
+			return errOperationInProgress
+		}
+		if op.Error != nil {
+			return backoff.Permanent(&OperationError{
+				OperationID: operationID,
+				Reason:      op.Error.Reason,
+				Details:     op.Error.Details,
+			})
+		}
+		return nil
+	}, backoff.WithContext(newOperationBackOff(), ctx))
+	if errors.Is(err, errOperationInProgress) {
+		// Backoff stops before the deadline when next retry wouldn't fit into it.
+		err = context.DeadlineExceeded
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return op, fmt.Errorf("waiting for operation %s: %w", operationID, err)
+	}
+	return op, err
+}
+
+// isClientError returns true if API rejected request and retrying it won't help.
+func isClientError(err error) bool {
+	var statusErr *StatusError
+	return errors.As(err, &statusErr) && statusErr.Status >= 400 && statusErr.Status < 500 && statusErr.Status != http.StatusTooManyRequests
+}
@@ -0,0 +1,101 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/castai/cli/pkg/client/sdk"
+)
+
+func TestWaitOperation(t *testing.T) {
+	defer func(f func() backoff.BackOff) { newOperationBackOff = f }(newOperationBackOff)
+	newOperationBackOff = func() backoff.BackOff {
+		return backoff.NewConstantBackOff(time.Millisecond)
+	}
+
+	t.Run("wait until done", func(t *testing.T) {
+		calls := 0
+		get := func(ctx context.Context, operationID string) (*sdk.OperationResponse, error) {
+			calls++
+			return &sdk.OperationResponse{Id: operationID, Done: calls == 3}, nil
+		}
+
+		op, err := WaitOperation(context.Background(), get, "op1")
+		require.NoError(t, err)
+		require.True(t, op.Done)
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("return operation error", func(t *testing.T) {
+		get := func(ctx context.Context, operationID string) (*sdk.OperationResponse, error) {
+			op := &sdk.OperationResponse{Id: operationID, Done: true}
+			op.Error = &struct {
+				Details string `json:"details"`
+				Reason  string `json:"reason"`
+			}{Details: "quota exceeded", Reason: "internal_error"}
+			return op, nil
+		}
+
+		_, err := WaitOperation(context.Background(), get, "op1")
+		var opErr *OperationError
+		require.True(t, errors.As(err, &opErr))
+		require.EqualError(t, err, "operation op1 failed: internal_error: quota exceeded")
+	})
+
+	t.Run("stop on context deadline", func(t *testing.T) {
+		get := func(ctx context.Context, operationID string) (*sdk.OperationResponse, error) {
+			return &sdk.OperationResponse{Id: operationID}, nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := WaitOperation(ctx, get, "op1")
+		require.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+
+	t.Run("retry transient api errors", func(t *testing.T) {
+		calls := 0
+		get := func(ctx context.Context, operationID string) (*sdk.OperationResponse, error) {
+			calls++
+			if calls < 3 {
+				return nil, &StatusError{Expected: http.StatusOK, Status: http.StatusBadGateway}
+			}
+			return &sdk.OperationResponse{Id: operationID, Done: true}, nil
+		}
+
+		op, err := WaitOperation(context.Background(), get, "op1")
+		require.NoError(t, err)
+		require.True(t, op.Done)
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("stop on persistent api errors", func(t *testing.T) {
+		calls := 0
+		get := func(ctx context.Context, operationID string) (*sdk.OperationResponse, error) {
+			calls++
+			return nil, errors.New("connection refused")
+		}
+
+		_, err := WaitOperation(context.Background(), get, "op1")
+		require.EqualError(t, err, "connection refused")
+		require.Equal(t, operationMaxErrors, calls)
+	})
+
+	t.Run("stop on api client error", func(t *testing.T) {
+		calls := 0
+		get := func(ctx context.Context, operationID string) (*sdk.OperationResponse, error) {
+			calls++
+			return nil, &StatusError{Expected: http.StatusOK, Status: http.StatusNotFound, Body: "operation not found"}
+		}
+
+		_, err := WaitOperation(context.Background(), get, "op1")
+		require.EqualError(t, err, "expected status code 200, received: status=404 body=operation not found")
+		require.Equal(t, 1, calls)
+	})
+}
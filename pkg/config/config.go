@@ -29,6 +29,30 @@ type Config struct {
 }
 
 func LoadFromEnv() (*Config, error) {
+	config, err := LoadFromFile()
+	if err != nil {
+		return nil, err
+	}
+
+	// Override with env variables if any.
+	if hostname := os.Getenv(envApiHostname); hostname != "" {
+		config.Hostname = hostname
+	}
+	if accessToken := os.Getenv(envApiToken); accessToken != "" {
+		config.AccessToken = accessToken
+	}
+	if debug := os.Getenv(envDebug); debug != "" {
+		config.Debug = debug == "true" || debug == "1"
+	}
+	if region := os.Getenv(envDefaultRegion); region != "" {
+		config.DefaultRegion = region
+	}
+
+	return config, nil
+}
+
+// LoadFromFile loads config from file without env variables overrides. Defaults are returned if file doesn't exist.
+func LoadFromFile() (*Config, error) {
 	config := &Config{
 		Hostname:      "api.cast.ai",
 		AccessToken:   "",
@@ -36,7 +60,6 @@ func LoadFromEnv() (*Config, error) {
 		Debug:         false,
 	}
 
-	// Try to read config from file.
 	configPath, err := GetPath()
 	if err != nil {
 		return nil, err
@@ -54,22 +77,22 @@ func LoadFromEnv() (*Config, error) {
 	if err != nil && !os.IsNotExist(err) {
 		return nil, err
 	}
+	return config, nil
+}
 
-	// Override with env variables if any.
-	if hostname := os.Getenv(envApiHostname); hostname != "" {
-		config.Hostname = hostname
-	}
-	if accessToken := os.Getenv(envApiToken); accessToken != "" {
-		config.AccessToken = accessToken
-	}
-	if debug := os.Getenv(envDebug); debug != "" {
-		config.Debug = debug == "true" || debug == "1"
-	}
-	if region := os.Getenv(envDefaultRegion); region != "" {
-		config.DefaultRegion = region
+// SaveAccessToken updates only access token in the config file. Other values set by env variables are not persisted.
+func SaveAccessToken(token string) error {
+	cfg, err := LoadFromFile()
+	if err != nil {
+		return err
 	}
+	cfg.AccessToken = token
+	return Save(cfg)
+}
 
-	return config, nil
+// AccessTokenFromEnv returns true if access token is overridden by env variable.
+func AccessTokenFromEnv() bool {
+	return os.Getenv(envApiToken) != ""
 }
 
 func Save(cfg *Config) error {